@@ -0,0 +1,257 @@
+package cache
+
+import (
+	collections "github.com/wernerstrydom/go-collections"
+)
+
+// ARCCache implements a cache that holds up to a fixed number of entries and
+// evicts them using the adaptive replacement cache policy of Megiddo and
+// Modha. Entries that have been used once and entries that have been used
+// more than once are kept in separate lists, and the cache remembers the keys
+// of recently evicted entries from each list. A miss on a remembered key
+// shifts capacity towards the list it was evicted from, so the cache adapts
+// between favouring recency and favouring frequency. Get, Peek, Put and Remove
+// take constant time. It is not thread-safe.
+type ARCCache[K comparable, V any] struct {
+	// recent holds the entries used once, and frequent the entries used more
+	// than once, each from the most to the least recently used.
+	recent   collections.LinkedList[entry[K, V]]
+	frequent collections.LinkedList[entry[K, V]]
+
+	// recentGhosts and frequentGhosts hold the keys of entries recently
+	// evicted from recent and frequent. Their values are not kept.
+	recentGhosts   collections.LinkedList[entry[K, V]]
+	frequentGhosts collections.LinkedList[entry[K, V]]
+
+	// index holds the node of every key in any of the four lists.
+	index *collections.Dictionary[K, *collections.LinkedListNode[entry[K, V]]]
+
+	// target is the number of entries that recent should hold.
+	target   int
+	capacity int
+	onEvict  func(K, V)
+}
+
+// NewARCCache returns a new, empty cache that holds up to the given number of
+// entries. It panics if capacity is not positive.
+func NewARCCache[K comparable, V any](capacity int) *ARCCache[K, V] {
+	checkCapacity(capacity)
+	return &ARCCache[K, V]{
+		index:    collections.NewDictionary[K, *collections.LinkedListNode[entry[K, V]]](),
+		capacity: capacity,
+	}
+}
+
+// Get returns the value for the given key and true, and marks the key as
+// frequently used. If the key is not in the cache, false is returned.
+func (c *ARCCache[K, V]) Get(key K) (V, bool) {
+	n, ok := c.resident(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.promote(n)
+	return n.Value.value, true
+}
+
+// Peek returns the value for the given key and true, without marking the key
+// as used. If the key is not in the cache, false is returned.
+func (c *ARCCache[K, V]) Peek(key K) (V, bool) {
+	n, ok := c.resident(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return n.Value.value, true
+}
+
+// Put sets the value for the given key. If the key is already in the cache, or
+// was recently evicted, it is marked as frequently used. If the key is new and
+// the cache is full, an entry is evicted.
+func (c *ARCCache[K, V]) Put(key K, value V) {
+	n, ok := c.index.TryGet(key)
+	switch {
+	case ok && (n.List() == &c.recent || n.List() == &c.frequent):
+		n.Value.value = value
+		c.promote(n)
+		return
+
+	case ok && n.List() == &c.recentGhosts:
+		// The key was evicted too early from recent, so recent should be
+		// larger.
+		c.target = min(c.capacity, c.target+max(c.frequentGhosts.Size()/c.recentGhosts.Size(), 1))
+		c.replace(false)
+		_ = c.recentGhosts.Remove(n)
+		c.index.Set(key, c.frequent.AddFirst(entry[K, V]{key: key, value: value}))
+		return
+
+	case ok && n.List() == &c.frequentGhosts:
+		// The key was evicted too early from frequent, so frequent should be
+		// larger.
+		c.target = max(0, c.target-max(c.recentGhosts.Size()/c.frequentGhosts.Size(), 1))
+		c.replace(true)
+		_ = c.frequentGhosts.Remove(n)
+		c.index.Set(key, c.frequent.AddFirst(entry[K, V]{key: key, value: value}))
+		return
+	}
+
+	// The key is new. Keep the recent list and its ghosts within the
+	// capacity, and all four lists within twice the capacity.
+	if c.recent.Size()+c.recentGhosts.Size() >= c.capacity {
+		if c.recent.Size() < c.capacity {
+			c.forget(&c.recentGhosts)
+			c.replace(false)
+		} else {
+			c.evict(&c.recent, nil)
+		}
+	} else if c.recent.Size()+c.frequent.Size()+c.recentGhosts.Size()+c.frequentGhosts.Size() >= c.capacity {
+		if c.recent.Size()+c.frequent.Size()+c.recentGhosts.Size()+c.frequentGhosts.Size() >= 2*c.capacity {
+			c.forget(&c.frequentGhosts)
+		}
+		c.replace(false)
+	}
+	c.index.Set(key, c.recent.AddFirst(entry[K, V]{key: key, value: value}))
+}
+
+// Remove removes the given key and its value from the cache. If the key is not
+// found, false is returned, otherwise true is returned. A recently evicted key
+// is also forgotten, but false is returned.
+func (c *ARCCache[K, V]) Remove(key K) bool {
+	n, ok := c.index.TryGet(key)
+	if !ok {
+		return false
+	}
+
+	resident := n.List() == &c.recent || n.List() == &c.frequent
+	_ = n.List().Remove(n)
+	c.index.Remove(key)
+	return resident
+}
+
+// Len returns the number of entries in the cache.
+func (c *ARCCache[K, V]) Len() int {
+	return c.recent.Size() + c.frequent.Size()
+}
+
+// Capacity returns the maximum number of entries in the cache.
+func (c *ARCCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Resize changes the capacity of the cache, evicting entries if there are more
+// than the new capacity, and returns the number of entries evicted. It panics
+// if capacity is not positive.
+func (c *ARCCache[K, V]) Resize(capacity int) int {
+	checkCapacity(capacity)
+	c.capacity = capacity
+	c.target = min(c.target, capacity)
+
+	evicted := 0
+	for c.Len() > c.capacity {
+		c.replace(false)
+		evicted++
+	}
+	for c.recent.Size()+c.recentGhosts.Size() > c.capacity && !c.recentGhosts.IsEmpty() {
+		c.forget(&c.recentGhosts)
+	}
+	for c.Len()+c.recentGhosts.Size()+c.frequentGhosts.Size() > 2*c.capacity && !c.frequentGhosts.IsEmpty() {
+		c.forget(&c.frequentGhosts)
+	}
+	return evicted
+}
+
+// OnEvict sets a function that is called with the key and value of each entry
+// evicted to make room for others. It is not called for entries removed with
+// Remove.
+func (c *ARCCache[K, V]) OnEvict(f func(K, V)) {
+	c.onEvict = f
+}
+
+// resident returns the node of the given key if its entry is in the cache, as
+// opposed to a recently evicted key.
+func (c *ARCCache[K, V]) resident(key K) (*collections.LinkedListNode[entry[K, V]], bool) {
+	n, ok := c.index.TryGet(key)
+	if !ok || (n.List() != &c.recent && n.List() != &c.frequent) {
+		return nil, false
+	}
+	return n, true
+}
+
+// promote moves the node of a resident entry to the front of frequent.
+func (c *ARCCache[K, V]) promote(n *collections.LinkedListNode[entry[K, V]]) {
+	if n.List() == &c.frequent {
+		_ = c.frequent.MoveToFront(n)
+		return
+	}
+	_ = c.recent.Remove(n)
+	c.index.Set(n.Value.key, c.frequent.AddFirst(n.Value))
+}
+
+// replace evicts an entry to make room for another if the cache is full. It
+// evicts from recent if recent holds more than its target, and otherwise from
+// frequent. When the new entry is a key recently evicted from frequent,
+// recent is also chosen if it holds exactly its target.
+func (c *ARCCache[K, V]) replace(frequentGhost bool) {
+	if c.Len() < c.capacity {
+		return
+	}
+
+	size := c.recent.Size()
+	if size > 0 && (size > c.target || (frequentGhost && size == c.target) || c.frequent.IsEmpty()) {
+		c.evict(&c.recent, &c.recentGhosts)
+	} else {
+		c.evict(&c.frequent, &c.frequentGhosts)
+	}
+}
+
+// evict removes the least recently used entry from the given list, calling the
+// eviction function, and remembers its key in ghosts. If ghosts is nil, the
+// key is forgotten.
+func (c *ARCCache[K, V]) evict(list *collections.LinkedList[entry[K, V]], ghosts *collections.LinkedList[entry[K, V]]) {
+	n := list.Last()
+	_ = list.Remove(n)
+	if ghosts == nil {
+		c.index.Remove(n.Value.key)
+	} else {
+		c.index.Set(n.Value.key, ghosts.AddFirst(entry[K, V]{key: n.Value.key}))
+	}
+	if c.onEvict != nil {
+		c.onEvict(n.Value.key, n.Value.value)
+	}
+}
+
+// forget removes the least recently evicted key from the given ghost list.
+func (c *ARCCache[K, V]) forget(ghosts *collections.LinkedList[entry[K, V]]) {
+	n := ghosts.Last()
+	if n == nil {
+		return
+	}
+	_ = ghosts.Remove(n)
+	c.index.Remove(n.Value.key)
+}
+
+// ConcurrentARCCache implements a thread-safe ARCCache. The keys are split
+// across shards by their hash, and each shard is an ARCCache with its own lock
+// and its share of the capacity. Each shard adapts independently.
+type ConcurrentARCCache[K comparable, V any] struct {
+	sharded[K, V]
+}
+
+// NewConcurrentARCCache returns a new, empty cache that holds up to the given
+// number of entries, split across the given number of shards by a default hash
+// of the keys. Keys must be strings, booleans, numbers, pointers or channels;
+// for other keys, such as structs, use NewConcurrentARCCacheWithHash. It
+// panics if the keys need a hash function, or if capacity or shards is not
+// positive.
+func NewConcurrentARCCache[K comparable, V any](capacity int, shards int) *ConcurrentARCCache[K, V] {
+	return NewConcurrentARCCacheWithHash[K, V](capacity, shards, defaultHash[K]())
+}
+
+// NewConcurrentARCCacheWithHash returns a new, empty cache that holds up to
+// the given number of entries, split across the given number of shards by the
+// given hash function. It panics if capacity or shards is not positive.
+func NewConcurrentARCCacheWithHash[K comparable, V any](capacity int, shards int, hash func(K) uint64) *ConcurrentARCCache[K, V] {
+	return &ConcurrentARCCache[K, V]{newSharded(capacity, shards, hash, func(capacity int) Cache[K, V] {
+		return NewARCCache[K, V](capacity)
+	})}
+}
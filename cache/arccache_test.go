@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// checkARCCache fails the test if the lists of the cache break the invariants
+// of the adaptive replacement policy.
+func checkARCCache[K comparable, V any](t *testing.T, c *ARCCache[K, V]) {
+	t.Helper()
+
+	t1, t2 := c.recent.Size(), c.frequent.Size()
+	b1, b2 := c.recentGhosts.Size(), c.frequentGhosts.Size()
+	switch {
+	case t1+t2 > c.capacity:
+		t.Fatalf("cache holds %v entries, want at most %v", t1+t2, c.capacity)
+	case t1+b1 > c.capacity:
+		t.Fatalf("recent list and its ghosts hold %v keys, want at most %v", t1+b1, c.capacity)
+	case t1+t2+b1+b2 > 2*c.capacity:
+		t.Fatalf("cache remembers %v keys, want at most %v", t1+t2+b1+b2, 2*c.capacity)
+	case c.target < 0 || c.target > c.capacity:
+		t.Fatalf("target = %v, want between 0 and %v", c.target, c.capacity)
+	case c.index.Size() != t1+t2+b1+b2:
+		t.Fatalf("index holds %v keys, want %v", c.index.Size(), t1+t2+b1+b2)
+	}
+}
+
+func TestARCCache_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	c := NewARCCache[int, int](16)
+	resident := map[int]bool{}
+	c.OnEvict(func(k int, v int) {
+		if !resident[k] || v != k {
+			t.Fatalf("OnEvict(%v, %v) for a key that is not in the cache", k, v)
+		}
+		delete(resident, k)
+	})
+
+	for i := 0; i < 20000; i++ {
+		// Mix a small set of hot keys with a scan over many cold keys.
+		key := r.Intn(8)
+		if r.Intn(2) == 0 {
+			key = r.Intn(200)
+		}
+
+		switch r.Intn(10) {
+		case 0:
+			if got := c.Remove(key); got != resident[key] {
+				t.Fatalf("Remove(%v) = %v, want %v", key, got, resident[key])
+			}
+			delete(resident, key)
+		case 1, 2, 3:
+			if _, ok := c.Get(key); ok != resident[key] {
+				t.Fatalf("Get(%v) = %v, want %v", key, ok, resident[key])
+			}
+		default:
+			c.Put(key, key)
+			resident[key] = true
+		}
+		if i%1000 == 0 {
+			c.Resize(8 + r.Intn(16))
+		}
+		checkARCCache(t, c)
+		if c.Len() != len(resident) {
+			t.Fatalf("Len() = %v, want %v", c.Len(), len(resident))
+		}
+	}
+}
+
+func TestARCCache_Adapts(t *testing.T) {
+	c := NewARCCache[int, int](4)
+
+	// Keys used twice move to the frequent list, and survive a scan of keys
+	// used only once.
+	for _, k := range []int{1, 2} {
+		c.Put(k, k)
+		c.Get(k)
+	}
+	for k := 10; k < 30; k++ {
+		c.Put(k, k)
+	}
+	for _, k := range []int{1, 2} {
+		if _, ok := c.Peek(k); !ok {
+			t.Errorf("Peek(%v) = false, want true", k)
+		}
+	}
+	checkARCCache(t, c)
+
+	// A key evicted from the recent list too early makes it larger.
+	target := c.target
+	c.Put(28, 28)
+	c.Put(29, 29)
+	c.Put(27, 27)
+	checkARCCache(t, c)
+	if c.target <= target {
+		t.Errorf("target = %v, want more than %v", c.target, target)
+	}
+	if _, ok := c.Peek(27); !ok {
+		t.Errorf("Peek(27) = false, want true")
+	}
+}
+
+func ExampleARCCache() {
+	c := NewARCCache[string, int](2)
+	c.Put("a", 1)
+	c.Get("a")
+	c.Put("b", 2)
+	c.Put("c", 3)
+
+	_, ok := c.Peek("a")
+	fmt.Println(ok, c.Len())
+	// Output: true 2
+}
@@ -0,0 +1,203 @@
+// Package cache implements fixed-capacity caches that evict entries using the
+// least recently used (LRU), least frequently used (LFU) and adaptive
+// replacement (ARC) policies. The caches are built on the LinkedList and
+// Dictionary types of the collections package.
+//
+// LRUCache, LFUCache and ARCCache are not thread-safe. ConcurrentLRUCache,
+// ConcurrentLFUCache and ConcurrentARCCache split their entries across
+// shards, each guarded by its own lock, so that goroutines working on
+// different keys rarely contend.
+package cache
+
+import (
+	"sync"
+)
+
+// Cache is implemented by every cache in this package.
+type Cache[K comparable, V any] interface {
+	// Get returns the value for the given key and true, and records that the
+	// key was used. If the key is not in the cache, false is returned.
+	Get(key K) (V, bool)
+
+	// Peek returns the value for the given key and true, without recording
+	// that the key was used. If the key is not in the cache, false is
+	// returned.
+	Peek(key K) (V, bool)
+
+	// Put sets the value for the given key. If the key is new and the cache is
+	// full, an entry is evicted to make room for it.
+	Put(key K, value V)
+
+	// Remove removes the given key and its value from the cache. If the key is
+	// not found, false is returned, otherwise true is returned.
+	Remove(key K) bool
+
+	// Len returns the number of entries in the cache.
+	Len() int
+
+	// Capacity returns the maximum number of entries in the cache.
+	Capacity() int
+
+	// Resize changes the capacity of the cache, evicting entries if there are
+	// more than the new capacity, and returns the number of entries evicted.
+	Resize(capacity int) int
+
+	// OnEvict sets a function that is called with the key and value of each
+	// entry evicted to make room for others. It is not called for entries
+	// removed with Remove.
+	OnEvict(f func(K, V))
+}
+
+var (
+	_ Cache[int, int] = (*LRUCache[int, int])(nil)
+	_ Cache[int, int] = (*LFUCache[int, int])(nil)
+	_ Cache[int, int] = (*ARCCache[int, int])(nil)
+
+	_ Cache[int, int] = (*ConcurrentLRUCache[int, int])(nil)
+	_ Cache[int, int] = (*ConcurrentLFUCache[int, int])(nil)
+	_ Cache[int, int] = (*ConcurrentARCCache[int, int])(nil)
+)
+
+// checkCapacity panics if the capacity is not positive.
+func checkCapacity(capacity int) {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+}
+
+// shard is a cache guarded by a lock. The lock is not a read-write lock,
+// because Get changes the order of the entries.
+type shard[K comparable, V any] struct {
+	mutex sync.Mutex
+	cache Cache[K, V]
+}
+
+// sharded implements a thread-safe cache by splitting the keys across shards
+// by their hash. The capacity is divided between the shards as evenly as
+// possible.
+type sharded[K comparable, V any] struct {
+	shards []shard[K, V]
+	hash   func(K) uint64
+}
+
+// newSharded returns a sharded cache with the given total capacity and number
+// of shards, using newCache to create the cache in each shard. There are never
+// more shards than the capacity, so that every shard can hold an entry.
+func newSharded[K comparable, V any](capacity int, shards int, hash func(K) uint64, newCache func(int) Cache[K, V]) sharded[K, V] {
+	checkCapacity(capacity)
+	if shards <= 0 {
+		panic("cache: number of shards must be positive")
+	}
+
+	s := sharded[K, V]{shards: make([]shard[K, V], min(shards, capacity)), hash: hash}
+	for i := range s.shards {
+		s.shards[i].cache = newCache(s.capacityOf(i, capacity))
+	}
+	return s
+}
+
+// capacityOf returns the capacity of the shard with the given index when the
+// total capacity is divided between the shards.
+func (s *sharded[K, V]) capacityOf(i int, capacity int) int {
+	n := len(s.shards)
+	if i < capacity%n {
+		return capacity/n + 1
+	}
+	return capacity / n
+}
+
+// shardOf returns the shard that holds the given key.
+func (s *sharded[K, V]) shardOf(key K) *shard[K, V] {
+	return &s.shards[s.hash(key)%uint64(len(s.shards))]
+}
+
+// Get returns the value for the given key and true, and records that the key
+// was used. If the key is not in the cache, false is returned.
+func (s *sharded[K, V]) Get(key K) (V, bool) {
+	sh := s.shardOf(key)
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	return sh.cache.Get(key)
+}
+
+// Peek returns the value for the given key and true, without recording that
+// the key was used. If the key is not in the cache, false is returned.
+func (s *sharded[K, V]) Peek(key K) (V, bool) {
+	sh := s.shardOf(key)
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	return sh.cache.Peek(key)
+}
+
+// Put sets the value for the given key. If the key is new and its shard is
+// full, an entry in the same shard is evicted to make room for it.
+func (s *sharded[K, V]) Put(key K, value V) {
+	sh := s.shardOf(key)
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	sh.cache.Put(key, value)
+}
+
+// Remove removes the given key and its value from the cache. If the key is not
+// found, false is returned, otherwise true is returned.
+func (s *sharded[K, V]) Remove(key K) bool {
+	sh := s.shardOf(key)
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	return sh.cache.Remove(key)
+}
+
+// Len returns the number of entries in the cache. The shards are counted one
+// at a time, so the result may be stale if the cache is being changed.
+func (s *sharded[K, V]) Len() int {
+	n := 0
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mutex.Lock()
+		n += sh.cache.Len()
+		sh.mutex.Unlock()
+	}
+	return n
+}
+
+// Capacity returns the maximum number of entries in the cache.
+func (s *sharded[K, V]) Capacity() int {
+	n := 0
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mutex.Lock()
+		n += sh.cache.Capacity()
+		sh.mutex.Unlock()
+	}
+	return n
+}
+
+// Resize changes the capacity of the cache, dividing it between the shards,
+// and returns the number of entries evicted. The number of shards does not
+// change and every shard holds at least one entry, so if the capacity is less
+// than the number of shards, the capacity becomes the number of shards. It
+// panics if the capacity is not positive.
+func (s *sharded[K, V]) Resize(capacity int) int {
+	checkCapacity(capacity)
+
+	evicted := 0
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mutex.Lock()
+		evicted += sh.cache.Resize(max(s.capacityOf(i, capacity), 1))
+		sh.mutex.Unlock()
+	}
+	return evicted
+}
+
+// OnEvict sets a function that is called with the key and value of each entry
+// evicted to make room for others. It is called while the shard is locked, so
+// it must not use the cache.
+func (s *sharded[K, V]) OnEvict(f func(K, V)) {
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mutex.Lock()
+		sh.cache.OnEvict(f)
+		sh.mutex.Unlock()
+	}
+}
@@ -0,0 +1,232 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// newTestCaches returns an empty cache of each kind, with the given capacity.
+func newTestCaches(capacity int) map[string]Cache[int, string] {
+	return map[string]Cache[int, string]{
+		"LRUCache":           NewLRUCache[int, string](capacity),
+		"LFUCache":           NewLFUCache[int, string](capacity),
+		"ARCCache":           NewARCCache[int, string](capacity),
+		"ConcurrentLRUCache": NewConcurrentLRUCache[int, string](capacity, 1),
+		"ConcurrentLFUCache": NewConcurrentLFUCache[int, string](capacity, 1),
+		"ConcurrentARCCache": NewConcurrentARCCache[int, string](capacity, 1),
+	}
+}
+
+func TestCache(t *testing.T) {
+	for name, c := range newTestCaches(3) {
+		t.Run(name, func(t *testing.T) {
+			var evicted []int
+			c.OnEvict(func(k int, v string) {
+				if v != fmt.Sprint(k) {
+					t.Errorf("OnEvict(%v, %v) has the wrong value", k, v)
+				}
+				evicted = append(evicted, k)
+			})
+
+			for i := 1; i <= 3; i++ {
+				c.Put(i, fmt.Sprint(i))
+			}
+			if got := c.Len(); got != 3 {
+				t.Errorf("Len() = %v, want %v", got, 3)
+			}
+			if got, ok := c.Get(2); got != "2" || !ok {
+				t.Errorf("Get(2) = %v, %v, want %v, %v", got, ok, "2", true)
+			}
+			if got, ok := c.Peek(3); got != "3" || !ok {
+				t.Errorf("Peek(3) = %v, %v, want %v, %v", got, ok, "3", true)
+			}
+			if _, ok := c.Get(4); ok {
+				t.Errorf("Get(4) = true, want false")
+			}
+
+			// Adding a fourth key evicts one entry.
+			c.Put(4, "4")
+			if got := c.Len(); got != 3 {
+				t.Errorf("Len() = %v, want %v", got, 3)
+			}
+			if len(evicted) != 1 {
+				t.Fatalf("evicted = %v, want one key", evicted)
+			}
+			if _, ok := c.Peek(evicted[0]); ok {
+				t.Errorf("Peek(%v) after eviction = true, want false", evicted[0])
+			}
+
+			// Removing does not call OnEvict.
+			if !c.Remove(4) || c.Remove(4) {
+				t.Errorf("Remove(4) did not report whether the key was found")
+			}
+			if len(evicted) != 1 {
+				t.Errorf("Remove() called OnEvict")
+			}
+
+			if got := c.Resize(1); got != 1 || c.Len() != 1 || c.Capacity() != 1 {
+				t.Errorf("Resize(1) = %v, Len() = %v, Capacity() = %v, want %v, %v, %v", got, c.Len(), c.Capacity(), 1, 1, 1)
+			}
+			if len(evicted) != 2 {
+				t.Errorf("evicted = %v, want two keys", evicted)
+			}
+			if got := c.Resize(5); got != 0 {
+				t.Errorf("Resize(5) = %v, want %v", got, 0)
+			}
+		})
+	}
+}
+
+func TestCache_Update(t *testing.T) {
+	for name, c := range newTestCaches(2) {
+		t.Run(name, func(t *testing.T) {
+			evictions := 0
+			c.OnEvict(func(int, string) { evictions++ })
+
+			c.Put(1, "a")
+			c.Put(1, "b")
+			c.Put(2, "c")
+			if got, _ := c.Get(1); got != "b" {
+				t.Errorf("Get(1) = %v, want %v", got, "b")
+			}
+			if c.Len() != 2 || evictions != 0 {
+				t.Errorf("Len() = %v, evictions = %v, want %v, %v", c.Len(), evictions, 2, 0)
+			}
+		})
+	}
+}
+
+func TestCache_Capacity(t *testing.T) {
+	for name, c := range newTestCaches(10) {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 1000; i++ {
+				c.Put(i%37, "")
+				c.Get(i % 11)
+				if c.Len() > 10 {
+					t.Fatalf("Len() = %v, want at most %v", c.Len(), 10)
+				}
+			}
+		})
+	}
+}
+
+func TestNewCache_Panics(t *testing.T) {
+	tests := map[string]func(){
+		"LRUCache":           func() { NewLRUCache[int, int](0) },
+		"LFUCache":           func() { NewLFUCache[int, int](-1) },
+		"ARCCache":           func() { NewARCCache[int, int](0) },
+		"ConcurrentLRUCache": func() { NewConcurrentLRUCache[int, int](10, 0) },
+		"Resize":             func() { NewLRUCache[int, int](1).Resize(0) },
+		"ConcurrentResize":   func() { NewConcurrentLRUCache[int, int](8, 4).Resize(0) },
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("did not panic")
+				}
+			}()
+			f()
+		})
+	}
+}
+
+func TestConcurrentCache_Shards(t *testing.T) {
+	c := NewConcurrentLRUCache[int, int](10, 4)
+	if got := len(c.shards); got != 4 {
+		t.Errorf("shards = %v, want %v", got, 4)
+	}
+	if got := c.Capacity(); got != 10 {
+		t.Errorf("Capacity() = %v, want %v", got, 10)
+	}
+
+	// There are never more shards than the capacity.
+	small := NewConcurrentLRUCache[int, int](2, 8)
+	if got := len(small.shards); got != 2 {
+		t.Errorf("shards = %v, want %v", got, 2)
+	}
+
+	c.Resize(7)
+	if got := c.Capacity(); got != 7 {
+		t.Errorf("Capacity() after Resize(7) = %v, want %v", got, 7)
+	}
+
+	// Every shard keeps room for an entry.
+	for i := 0; i < 10; i++ {
+		c.Put(i, i)
+	}
+	before := c.Len()
+	if got := c.Resize(2); got != before-c.Len() || c.Len() > 4 || c.Capacity() != 4 {
+		t.Errorf("Resize(2) = %v, Len() = %v, Capacity() = %v, want %v, at most %v, %v", got, c.Len(), c.Capacity(), before-c.Len(), 4, 4)
+	}
+}
+
+func TestConcurrentCache_Race(t *testing.T) {
+	caches := map[string]Cache[int, int]{
+		"ConcurrentLRUCache": NewConcurrentLRUCache[int, int](64, 8),
+		"ConcurrentLFUCache": NewConcurrentLFUCache[int, int](64, 8),
+		"ConcurrentARCCache": NewConcurrentARCCache[int, int](64, 8),
+	}
+	for name, c := range caches {
+		t.Run(name, func(t *testing.T) {
+			var mutex sync.Mutex
+			evicted := 0
+			c.OnEvict(func(int, int) {
+				mutex.Lock()
+				evicted++
+				mutex.Unlock()
+			})
+
+			var wg sync.WaitGroup
+			for g := 0; g < 8; g++ {
+				wg.Add(1)
+				go func(g int) {
+					defer wg.Done()
+					for i := 0; i < 2000; i++ {
+						key := (i * (g + 1)) % 200
+						if v, ok := c.Get(key); ok && v != key {
+							t.Errorf("Get(%v) = %v", key, v)
+						}
+						c.Put(key, key)
+						if i%50 == 0 {
+							c.Remove(key)
+						}
+					}
+				}(g)
+			}
+			wg.Wait()
+
+			if got := c.Len(); got > 64 {
+				t.Errorf("Len() = %v, want at most %v", got, 64)
+			}
+			if evicted == 0 {
+				t.Errorf("no entries were evicted")
+			}
+		})
+	}
+}
+
+func BenchmarkCache_Put(b *testing.B) {
+	for name, c := range newTestCaches(1024) {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				c.Put(i%4096, "")
+			}
+		})
+	}
+}
+
+func BenchmarkConcurrentLRUCache_Contention(b *testing.B) {
+	c := NewConcurrentLRUCache[int, int](1024, 16)
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if _, ok := c.Get(i % 4096); !ok {
+				c.Put(i%4096, i)
+			}
+			i++
+		}
+	})
+}
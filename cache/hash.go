@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// seed is the seed of the default hash, chosen when the program starts.
+var seed = maphash.MakeSeed()
+
+// defaultHash returns the hash function used by the concurrent caches when no
+// hash function is given. Keys whose kind is a string, boolean or number are
+// hashed by their value, and pointers and channels by their address, so equal
+// keys always hash the same. It panics for any other kind of key, such as a
+// struct, array or interface, because those need a caller-supplied hash.
+func defaultHash[K comparable]() func(K) uint64 {
+	var h any
+	switch any(*new(K)).(type) {
+	case string:
+		h = func(k string) uint64 { return maphash.String(seed, k) }
+	case int:
+		h = func(k int) uint64 { return mix(uint64(k)) }
+	case int64:
+		h = func(k int64) uint64 { return mix(uint64(k)) }
+	case uint64:
+		h = mix
+	}
+	if h != nil {
+		return h.(func(K) uint64)
+	}
+
+	t := reflect.TypeFor[K]()
+	switch t.Kind() {
+	case reflect.String:
+		return func(k K) uint64 { return maphash.String(seed, reflect.ValueOf(k).String()) }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(k K) uint64 { return mix(uint64(reflect.ValueOf(k).Int())) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(k K) uint64 { return mix(reflect.ValueOf(k).Uint()) }
+	case reflect.Bool:
+		return func(k K) uint64 {
+			if reflect.ValueOf(k).Bool() {
+				return mix(1)
+			}
+			return mix(0)
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(k K) uint64 { return hashFloat(reflect.ValueOf(k).Float()) }
+	case reflect.Complex64, reflect.Complex128:
+		return func(k K) uint64 {
+			c := reflect.ValueOf(k).Complex()
+			return mix(hashFloat(real(c)) ^ hashFloat(imag(c)))
+		}
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return func(k K) uint64 { return mix(uint64(reflect.ValueOf(k).Pointer())) }
+	default:
+		panic(fmt.Sprintf("cache: keys of type %v need a hash function", t))
+	}
+}
+
+// hashFloat returns a hash of the given float. Positive and negative zero are
+// equal, so they hash the same.
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		return mix(0)
+	}
+	return mix(math.Float64bits(f))
+}
+
+// mix spreads the bits of an integer, so that keys that differ only in their
+// high bits still land in different shards.
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDefaultHash(t *testing.T) {
+	hashString := defaultHash[string]()
+	if hashString("apple") != hashString("apple") || hashString("apple") == hashString("banana") {
+		t.Errorf("defaultHash() of strings does not match their equality")
+	}
+	hashInt64 := defaultHash[int64]()
+	if hashInt64(1<<40) == hashInt64(2<<40) {
+		t.Errorf("defaultHash() of integers does not spread them")
+	}
+	if hashFloat := defaultHash[float64](); hashFloat(0) != hashFloat(math.Copysign(0, -1)) {
+		t.Errorf("defaultHash() of positive and negative zero differ")
+	}
+
+	// Named types are hashed by their kind, not by their String method.
+	type name string
+	if got, want := defaultHash[name]()("apple"), hashString("apple"); got != want {
+		t.Errorf("defaultHash() of a named string = %v, want %v", got, want)
+	}
+	if got, want := defaultHash[time.Duration]()(time.Second), hashInt64(int64(time.Second)); got != want {
+		t.Errorf("defaultHash() of a named int = %v, want %v", got, want)
+	}
+}
+
+func TestDefaultHash_Panics(t *testing.T) {
+	type point struct{ x, y float64 }
+	tests := map[string]func(){
+		"struct":    func() { NewConcurrentLRUCache[point, int](4, 2) },
+		"array":     func() { NewConcurrentLFUCache[[2]int, int](4, 2) },
+		"interface": func() { NewConcurrentARCCache[any, int](4, 2) },
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("did not panic")
+				}
+			}()
+			f()
+		})
+	}
+}
+
+func TestConcurrentCache_PointerKey(t *testing.T) {
+	type entry struct{ name string }
+	c := NewConcurrentLRUCache[*entry, int](64, 8)
+	keys := make([]*entry, 16)
+	for i := range keys {
+		keys[i] = &entry{name: "before"}
+		c.Put(keys[i], i)
+	}
+
+	// Pointer keys are compared by address, so changing what they point to
+	// does not move them to another shard.
+	for i, k := range keys {
+		k.name = "after"
+		if got, ok := c.Get(k); got != i || !ok {
+			t.Errorf("Get() = %v, %v, want %v, %v", got, ok, i, true)
+		}
+	}
+	for _, k := range keys {
+		if !c.Remove(k) {
+			t.Errorf("Remove() = false, want true")
+		}
+	}
+	if got := c.Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
+
+func TestConcurrentCache_WithHash(t *testing.T) {
+	calls := 0
+	hash := func(key string) uint64 {
+		calls++
+		return uint64(len(key))
+	}
+	caches := map[string]Cache[string, int]{
+		"ConcurrentLRUCache": NewConcurrentLRUCacheWithHash[string, int](4, 2, hash),
+		"ConcurrentLFUCache": NewConcurrentLFUCacheWithHash[string, int](4, 2, hash),
+		"ConcurrentARCCache": NewConcurrentARCCacheWithHash[string, int](4, 2, hash),
+	}
+	for name, c := range caches {
+		t.Run(name, func(t *testing.T) {
+			calls = 0
+			c.Put("a", 1)
+			if got, ok := c.Get("a"); got != 1 || !ok {
+				t.Errorf("Get() = %v, %v, want %v, %v", got, ok, 1, true)
+			}
+			if calls != 2 {
+				t.Errorf("hash called %v times, want %v", calls, 2)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package cache
+
+import (
+	collections "github.com/wernerstrydom/go-collections"
+)
+
+// lfuEntry is an entry in an LFUCache. It records its node in the list of
+// entries of its bucket, and the node of the bucket in the list of buckets.
+type lfuEntry[K comparable, V any] struct {
+	key    K
+	value  V
+	node   *collections.LinkedListNode[*lfuEntry[K, V]]
+	bucket *collections.LinkedListNode[*lfuBucket[K, V]]
+}
+
+// lfuBucket holds the entries of an LFUCache that have been used the same
+// number of times, from the most to the least recently used.
+type lfuBucket[K comparable, V any] struct {
+	count   int
+	entries collections.LinkedList[*lfuEntry[K, V]]
+}
+
+// LFUCache implements a cache that holds up to a fixed number of entries and,
+// when full, evicts the entry that was least frequently used. If several
+// entries have been used equally often, the least recently used of them is
+// evicted. Get, Peek, Put and Remove take constant time. It is not
+// thread-safe.
+//
+// An entry's count of uses starts at one when it is added, and is incremented
+// by Get and by Put on an existing key. Removing or evicting an entry forgets
+// its count.
+type LFUCache[K comparable, V any] struct {
+	buckets  collections.LinkedList[*lfuBucket[K, V]]
+	index    *collections.Dictionary[K, *lfuEntry[K, V]]
+	size     int
+	capacity int
+	onEvict  func(K, V)
+}
+
+// NewLFUCache returns a new, empty cache that holds up to the given number of
+// entries. It panics if capacity is not positive.
+func NewLFUCache[K comparable, V any](capacity int) *LFUCache[K, V] {
+	checkCapacity(capacity)
+	return &LFUCache[K, V]{
+		index:    collections.NewDictionary[K, *lfuEntry[K, V]](),
+		capacity: capacity,
+	}
+}
+
+// Get returns the value for the given key and true, and increments the count
+// of uses of the key. If the key is not in the cache, false is returned.
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.index.TryGet(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.touch(e)
+	return e.value, true
+}
+
+// Peek returns the value for the given key and true, without changing the
+// count of uses of the key. If the key is not in the cache, false is returned.
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.index.TryGet(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Put sets the value for the given key and increments its count of uses. If
+// the key is new and the cache is full, the least frequently used entry is
+// evicted.
+func (c *LFUCache[K, V]) Put(key K, value V) {
+	if e, ok := c.index.TryGet(key); ok {
+		e.value = value
+		c.touch(e)
+		return
+	}
+
+	if c.size >= c.capacity {
+		c.evict()
+	}
+
+	first := c.buckets.First()
+	if first == nil || first.Value.count != 1 {
+		first = c.buckets.AddFirst(&lfuBucket[K, V]{count: 1})
+	}
+	e := &lfuEntry[K, V]{key: key, value: value, bucket: first}
+	e.node = first.Value.entries.AddFirst(e)
+	c.index.Set(key, e)
+	c.size++
+}
+
+// Remove removes the given key and its value from the cache. If the key is not
+// found, false is returned, otherwise true is returned.
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	e, ok := c.index.TryGet(key)
+	if !ok {
+		return false
+	}
+	c.index.Remove(key)
+	c.unlink(e)
+	return true
+}
+
+// Len returns the number of entries in the cache.
+func (c *LFUCache[K, V]) Len() int {
+	return c.size
+}
+
+// Capacity returns the maximum number of entries in the cache.
+func (c *LFUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Resize changes the capacity of the cache, evicting the least frequently
+// used entries if there are more than the new capacity, and returns the number
+// of entries evicted. It panics if capacity is not positive.
+func (c *LFUCache[K, V]) Resize(capacity int) int {
+	checkCapacity(capacity)
+	c.capacity = capacity
+
+	evicted := 0
+	for c.size > c.capacity {
+		c.evict()
+		evicted++
+	}
+	return evicted
+}
+
+// OnEvict sets a function that is called with the key and value of each entry
+// evicted to make room for others. It is not called for entries removed with
+// Remove.
+func (c *LFUCache[K, V]) OnEvict(f func(K, V)) {
+	c.onEvict = f
+}
+
+// touch increments the count of uses of the entry, moving it to the front of
+// the bucket for the next count.
+func (c *LFUCache[K, V]) touch(e *lfuEntry[K, V]) {
+	current := e.bucket
+	next := current.Next()
+	if next == nil || next.Value.count != current.Value.count+1 {
+		next, _ = c.buckets.AddAfter(current, &lfuBucket[K, V]{count: current.Value.count + 1})
+	}
+
+	_ = current.Value.entries.Remove(e.node)
+	if current.Value.entries.IsEmpty() {
+		_ = c.buckets.Remove(current)
+	}
+	e.bucket = next
+	e.node = next.Value.entries.AddFirst(e)
+}
+
+// unlink removes the entry from its bucket, and removes the bucket if it is
+// left empty.
+func (c *LFUCache[K, V]) unlink(e *lfuEntry[K, V]) {
+	b := e.bucket
+	_ = b.Value.entries.Remove(e.node)
+	if b.Value.entries.IsEmpty() {
+		_ = c.buckets.Remove(b)
+	}
+	c.size--
+}
+
+// evict removes the least recently used of the least frequently used entries.
+func (c *LFUCache[K, V]) evict() {
+	e := c.buckets.First().Value.entries.Last().Value
+	c.index.Remove(e.key)
+	c.unlink(e)
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// ConcurrentLFUCache implements a thread-safe LFUCache. The keys are split
+// across shards by their hash, and each shard is an LFUCache with its own lock
+// and its share of the capacity. An entry is evicted when its shard is full,
+// so it is the least frequently used entry of its shard rather than of the
+// whole cache.
+type ConcurrentLFUCache[K comparable, V any] struct {
+	sharded[K, V]
+}
+
+// NewConcurrentLFUCache returns a new, empty cache that holds up to the given
+// number of entries, split across the given number of shards by a default hash
+// of the keys. Keys must be strings, booleans, numbers, pointers or channels;
+// for other keys, such as structs, use NewConcurrentLFUCacheWithHash. It
+// panics if the keys need a hash function, or if capacity or shards is not
+// positive.
+func NewConcurrentLFUCache[K comparable, V any](capacity int, shards int) *ConcurrentLFUCache[K, V] {
+	return NewConcurrentLFUCacheWithHash[K, V](capacity, shards, defaultHash[K]())
+}
+
+// NewConcurrentLFUCacheWithHash returns a new, empty cache that holds up to
+// the given number of entries, split across the given number of shards by the
+// given hash function. It panics if capacity or shards is not positive.
+func NewConcurrentLFUCacheWithHash[K comparable, V any](capacity int, shards int, hash func(K) uint64) *ConcurrentLFUCache[K, V] {
+	return &ConcurrentLFUCache[K, V]{newSharded(capacity, shards, hash, func(capacity int) Cache[K, V] {
+		return NewLFUCache[K, V](capacity)
+	})}
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// checkLFUCache fails the test if the buckets of the cache are not in
+// ascending order of count, or the cache does not hold the given number of
+// entries.
+func checkLFUCache[K comparable, V any](t *testing.T, c *LFUCache[K, V], size int) {
+	t.Helper()
+
+	count, n := 0, 0
+	for b := range c.buckets.Values() {
+		if b.count <= count || b.entries.IsEmpty() {
+			t.Fatalf("bucket %v follows bucket %v or is empty", b.count, count)
+		}
+		count = b.count
+		n += b.entries.Size()
+	}
+	if n != size || c.Len() != size || c.index.Size() != size {
+		t.Fatalf("cache holds %v entries, Len() = %v, want %v", n, c.Len(), size)
+	}
+}
+
+func TestLFUCache_Order(t *testing.T) {
+	c := NewLFUCache[int, int](3)
+	var evicted []int
+	c.OnEvict(func(k int, _ int) { evicted = append(evicted, k) })
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+	c.Peek(3)
+	checkLFUCache(t, c, 3)
+
+	// 3 has been used least often.
+	c.Put(4, 4)
+	// 4 has been used least often, even though it is the most recent.
+	c.Put(5, 5)
+	checkLFUCache(t, c, 3)
+	if !slices.Equal(evicted, []int{3, 4}) {
+		t.Errorf("evicted = %v, want %v", evicted, []int{3, 4})
+	}
+
+	// Among keys used equally often, the least recently used is evicted.
+	c.Get(5)
+	c.Get(5)
+	c.Put(6, 6)
+	c.Put(7, 7)
+	checkLFUCache(t, c, 3)
+	if !slices.Equal(evicted, []int{3, 4, 2, 6}) {
+		t.Errorf("evicted = %v, want %v", evicted, []int{3, 4, 2, 6})
+	}
+
+	c.Remove(1)
+	checkLFUCache(t, c, 2)
+	if got := c.Resize(1); got != 1 || !slices.Equal(evicted, []int{3, 4, 2, 6, 7}) {
+		t.Errorf("Resize(1) = %v, evicted = %v", got, evicted)
+	}
+	checkLFUCache(t, c, 1)
+}
+
+func ExampleLFUCache() {
+	c := NewLFUCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("c", 3)
+
+	_, ok := c.Peek("b")
+	fmt.Println(ok)
+	// Output: false
+}
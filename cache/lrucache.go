@@ -0,0 +1,152 @@
+package cache
+
+import (
+	collections "github.com/wernerstrydom/go-collections"
+)
+
+// entry is a key and its value, stored in the linked lists of a cache.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRUCache implements a cache that holds up to a fixed number of entries and,
+// when full, evicts the entry that was least recently used. Get, Peek, Put and
+// Remove take constant time. It is not thread-safe.
+type LRUCache[K comparable, V any] struct {
+	entries  collections.LinkedList[entry[K, V]]
+	index    *collections.Dictionary[K, *collections.LinkedListNode[entry[K, V]]]
+	capacity int
+	onEvict  func(K, V)
+}
+
+// NewLRUCache returns a new, empty cache that holds up to the given number of
+// entries. It panics if capacity is not positive.
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	checkCapacity(capacity)
+	return &LRUCache[K, V]{
+		index:    collections.NewDictionary[K, *collections.LinkedListNode[entry[K, V]]](),
+		capacity: capacity,
+	}
+}
+
+// Get returns the value for the given key and true, and marks the key as the
+// most recently used. If the key is not in the cache, false is returned.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	n, ok := c.index.TryGet(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	_ = c.entries.MoveToFront(n)
+	return n.Value.value, true
+}
+
+// Peek returns the value for the given key and true, without marking the key
+// as used. If the key is not in the cache, false is returned.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	n, ok := c.index.TryGet(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return n.Value.value, true
+}
+
+// Put sets the value for the given key and marks the key as the most recently
+// used. If the key is new and the cache is full, the least recently used entry
+// is evicted.
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	if n, ok := c.index.TryGet(key); ok {
+		n.Value.value = value
+		_ = c.entries.MoveToFront(n)
+		return
+	}
+
+	if c.entries.Size() >= c.capacity {
+		c.evict()
+	}
+	c.index.Set(key, c.entries.AddFirst(entry[K, V]{key: key, value: value}))
+}
+
+// Remove removes the given key and its value from the cache. If the key is not
+// found, false is returned, otherwise true is returned.
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	n, ok := c.index.TryGet(key)
+	if !ok {
+		return false
+	}
+	c.index.Remove(key)
+	_ = c.entries.Remove(n)
+	return true
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache[K, V]) Len() int {
+	return c.entries.Size()
+}
+
+// Capacity returns the maximum number of entries in the cache.
+func (c *LRUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Resize changes the capacity of the cache, evicting the least recently used
+// entries if there are more than the new capacity, and returns the number of
+// entries evicted. It panics if capacity is not positive.
+func (c *LRUCache[K, V]) Resize(capacity int) int {
+	checkCapacity(capacity)
+	c.capacity = capacity
+
+	evicted := 0
+	for c.entries.Size() > c.capacity {
+		c.evict()
+		evicted++
+	}
+	return evicted
+}
+
+// OnEvict sets a function that is called with the key and value of each entry
+// evicted to make room for others. It is not called for entries removed with
+// Remove.
+func (c *LRUCache[K, V]) OnEvict(f func(K, V)) {
+	c.onEvict = f
+}
+
+// evict removes the least recently used entry.
+func (c *LRUCache[K, V]) evict() {
+	n := c.entries.Last()
+	_ = c.entries.Remove(n)
+	c.index.Remove(n.Value.key)
+	if c.onEvict != nil {
+		c.onEvict(n.Value.key, n.Value.value)
+	}
+}
+
+// ConcurrentLRUCache implements a thread-safe LRUCache. The keys are split
+// across shards by their hash, and each shard is an LRUCache with its own lock
+// and its share of the capacity. An entry is evicted when its shard is full,
+// so it is the least recently used entry of its shard rather than of the whole
+// cache.
+type ConcurrentLRUCache[K comparable, V any] struct {
+	sharded[K, V]
+}
+
+// NewConcurrentLRUCache returns a new, empty cache that holds up to the given
+// number of entries, split across the given number of shards by a default hash
+// of the keys. Keys must be strings, booleans, numbers, pointers or channels;
+// for other keys, such as structs, use NewConcurrentLRUCacheWithHash. It
+// panics if the keys need a hash function, or if capacity or shards is not
+// positive.
+func NewConcurrentLRUCache[K comparable, V any](capacity int, shards int) *ConcurrentLRUCache[K, V] {
+	return NewConcurrentLRUCacheWithHash[K, V](capacity, shards, defaultHash[K]())
+}
+
+// NewConcurrentLRUCacheWithHash returns a new, empty cache that holds up to
+// the given number of entries, split across the given number of shards by the
+// given hash function. It panics if capacity or shards is not positive.
+func NewConcurrentLRUCacheWithHash[K comparable, V any](capacity int, shards int, hash func(K) uint64) *ConcurrentLRUCache[K, V] {
+	return &ConcurrentLRUCache[K, V]{newSharded(capacity, shards, hash, func(capacity int) Cache[K, V] {
+		return NewLRUCache[K, V](capacity)
+	})}
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// lruKeys returns the keys in the cache, from the most to the least recently
+// used.
+func lruKeys[K comparable, V any](c *LRUCache[K, V]) []K {
+	var keys []K
+	for e := range c.entries.Values() {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+func TestLRUCache_Order(t *testing.T) {
+	c := NewLRUCache[int, int](3)
+	var evicted []int
+	c.OnEvict(func(k int, _ int) { evicted = append(evicted, k) })
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	c.Get(1)
+	c.Peek(2)
+	if got := lruKeys(c); !slices.Equal(got, []int{1, 3, 2}) {
+		t.Errorf("keys = %v, want %v", got, []int{1, 3, 2})
+	}
+
+	c.Put(4, 4)
+	c.Put(3, 30)
+	c.Put(5, 5)
+	if !slices.Equal(evicted, []int{2, 1}) {
+		t.Errorf("evicted = %v, want %v", evicted, []int{2, 1})
+	}
+	if got := lruKeys(c); !slices.Equal(got, []int{5, 3, 4}) {
+		t.Errorf("keys = %v, want %v", got, []int{5, 3, 4})
+	}
+
+	if got := c.Resize(1); got != 2 || !slices.Equal(evicted, []int{2, 1, 4, 3}) {
+		t.Errorf("Resize(1) = %v, evicted = %v", got, evicted)
+	}
+}
+
+func ExampleLRUCache() {
+	c := NewLRUCache[string, int](2)
+	c.OnEvict(func(k string, v int) {
+		fmt.Println("evicted", k, v)
+	})
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+
+	_, ok := c.Get("b")
+	fmt.Println(ok, c.Len())
+	// Output:
+	// evicted b 2
+	// false 2
+}
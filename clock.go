@@ -0,0 +1,244 @@
+package collections
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock tells the time and waits for time to pass. Collections that deal with
+// time, such as ExpiringQueue, take a Clock so that tests can use a
+// ManualClock instead of sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After returns a channel that receives the current time once the given
+	// duration has passed. The wait cannot be cancelled, so code that may
+	// stop waiting early should use NewTimer instead.
+	After(d time.Duration) <-chan time.Time
+
+	// NewTimer returns a timer whose channel receives the current time once
+	// the given duration has passed, unless the timer is stopped first.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a cancellable wait for a duration to pass, returned by
+// Clock.NewTimer.
+type Timer interface {
+	// C returns the channel that receives the current time when the timer
+	// fires.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing. It returns true if the call stops
+	// the timer, or false if the timer has already fired or been stopped.
+	Stop() bool
+}
+
+// SystemClock implements Clock using the time package.
+type SystemClock struct{}
+
+// Now returns the current time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After returns a channel that receives the current time once the given
+// duration has passed.
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NewTimer returns a timer that wraps a time.Timer.
+func (SystemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+// systemTimer implements Timer using a time.Timer.
+type systemTimer struct {
+	timer *time.Timer
+}
+
+// C returns the channel of the timer.
+func (t systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+// Stop stops the timer.
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// ManualClock implements a Clock whose time only changes when Advance or Set
+// is called, so that tests can control time without sleeping. It is
+// thread-safe.
+type ManualClock struct {
+	now     time.Time
+	waiters []*manualClockWaiter
+	changed signal
+	mutex   sync.Mutex
+}
+
+// manualClockWaiter is a channel returned by ManualClock.After or
+// ManualClock.NewTimer, and the time at which it receives.
+type manualClockWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+// NewManualClock returns a new clock set to the given time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now returns the current time of the clock.
+func (c *ManualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// After returns a channel that receives the time of the clock once it has
+// been advanced by the given duration. If the duration is not positive, the
+// channel receives immediately.
+func (c *ManualClock) After(d time.Duration) <-chan time.Time {
+	return c.wait(d).ch
+}
+
+// NewTimer returns a timer whose channel receives the time of the clock once
+// it has been advanced by the given duration. If the duration is not
+// positive, the channel receives immediately. Stopping the timer stops it
+// from counting as a waiter in BlockUntil.
+func (c *ManualClock) NewTimer(d time.Duration) Timer {
+	return &manualTimer{clock: c, waiter: c.wait(d)}
+}
+
+// Advance moves the clock forward by the given duration, and wakes every
+// channel returned by After whose duration has passed.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.set(c.now.Add(d))
+}
+
+// Set sets the clock to the given time, and wakes every channel returned by
+// After whose duration has passed. Setting the clock to an earlier time does
+// not wake any channel.
+func (c *ManualClock) Set(now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.set(now)
+}
+
+// BlockUntil blocks until at least n channels returned by After, or timers
+// returned by NewTimer that have not been stopped, are waiting for the clock
+// to advance. Tests use it to make sure that a goroutine is
+// waiting before they advance the clock.
+func (c *ManualClock) BlockUntil(n int) {
+	for {
+		c.mutex.Lock()
+		if len(c.waiters) >= n {
+			c.mutex.Unlock()
+			return
+		}
+		ch := c.changed.wait()
+		c.mutex.Unlock()
+		<-ch
+	}
+}
+
+// wait returns a waiter that receives once the clock has been advanced by the
+// given duration. If the duration is not positive, it receives immediately
+// and is not added to the waiters.
+func (c *ManualClock) wait(d time.Duration) *manualClockWaiter {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	w := &manualClockWaiter{deadline: c.now.Add(d), ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		w.ch <- c.now
+		return w
+	}
+	c.waiters = append(c.waiters, w)
+	c.changed.broadcast()
+	return w
+}
+
+// remove removes the given waiter, and returns true if it was waiting.
+func (c *ManualClock) remove(w *manualClockWaiter) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	i := slices.Index(c.waiters, w)
+	if i < 0 {
+		return false
+	}
+	c.waiters = slices.Delete(c.waiters, i, i+1)
+	c.changed.broadcast()
+	return true
+}
+
+// manualTimer implements Timer for a ManualClock.
+type manualTimer struct {
+	clock  *ManualClock
+	waiter *manualClockWaiter
+}
+
+// C returns the channel of the timer.
+func (t *manualTimer) C() <-chan time.Time {
+	return t.waiter.ch
+}
+
+// Stop removes the timer from the clock's waiters.
+func (t *manualTimer) Stop() bool {
+	return t.clock.remove(t.waiter)
+}
+
+// set sets the time and wakes the waiters whose deadline has passed. The
+// caller must hold the lock.
+func (c *ManualClock) set(now time.Time) {
+	c.now = now
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if now.Before(w.deadline) {
+			waiters = append(waiters, w)
+		} else {
+			w.ch <- now
+		}
+	}
+	clear(c.waiters[len(waiters):])
+	c.waiters = waiters
+	c.changed.broadcast()
+}
+
+// startJanitor calls sweep every interval, as measured by the clock, until the
+// returned function is called. The returned function waits for the janitor to
+// stop, and may be called more than once. It panics if interval is not
+// positive.
+func startJanitor(clock Clock, interval time.Duration, sweep func()) func() {
+	if interval <= 0 {
+		panic("collections: janitor interval must be positive")
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			timer := clock.NewTimer(interval)
+			select {
+			case <-timer.C():
+				sweep()
+			case <-stop:
+				timer.Stop()
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(stop) })
+		<-done
+	}
+}
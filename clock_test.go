@@ -0,0 +1,141 @@
+package collections
+
+import (
+	"testing"
+	"time"
+)
+
+// epoch is the starting time of the manual clocks used in tests.
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestManualClock(t *testing.T) {
+	c := NewManualClock(epoch)
+	if got := c.Now(); !got.Equal(epoch) {
+		t.Errorf("Now() = %v, want %v", got, epoch)
+	}
+
+	short := c.After(time.Second)
+	long := c.After(time.Minute)
+	select {
+	case <-c.After(0):
+	default:
+		t.Errorf("After(0) did not receive immediately")
+	}
+
+	c.Advance(2 * time.Second)
+	select {
+	case got := <-short:
+		if want := epoch.Add(2 * time.Second); !got.Equal(want) {
+			t.Errorf("After(1s) received %v, want %v", got, want)
+		}
+	default:
+		t.Errorf("After(1s) did not receive after 2s")
+	}
+	select {
+	case <-long:
+		t.Errorf("After(1m) received after 2s")
+	default:
+	}
+
+	c.Set(epoch.Add(time.Hour))
+	select {
+	case <-long:
+	default:
+		t.Errorf("After(1m) did not receive after Set()")
+	}
+}
+
+func TestManualClock_BlockUntil(t *testing.T) {
+	c := NewManualClock(epoch)
+	done := make(chan struct{})
+	go func() {
+		<-c.After(time.Second)
+		close(done)
+	}()
+
+	c.BlockUntil(1)
+	c.Advance(time.Second)
+	<-done
+}
+
+// waiters returns the number of channels waiting for the clock to advance.
+func waiters(c *ManualClock) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.waiters)
+}
+
+func TestManualClock_NewTimer(t *testing.T) {
+	c := NewManualClock(epoch)
+	fired := c.NewTimer(time.Second)
+	stopped := c.NewTimer(time.Second)
+	if got := waiters(c); got != 2 {
+		t.Errorf("waiters = %v, want %v", got, 2)
+	}
+
+	if !stopped.Stop() {
+		t.Errorf("Stop() = false, want true")
+	}
+	if stopped.Stop() {
+		t.Errorf("Stop() of a stopped timer = true, want false")
+	}
+	if got := waiters(c); got != 1 {
+		t.Errorf("waiters after Stop() = %v, want %v", got, 1)
+	}
+
+	c.Advance(time.Second)
+	select {
+	case <-fired.C():
+	default:
+		t.Errorf("NewTimer(1s) did not receive after 1s")
+	}
+	select {
+	case <-stopped.C():
+		t.Errorf("a stopped timer received")
+	default:
+	}
+	if fired.Stop() {
+		t.Errorf("Stop() of a fired timer = true, want false")
+	}
+	if got := waiters(c); got != 0 {
+		t.Errorf("waiters = %v, want %v", got, 0)
+	}
+}
+
+func TestSystemClock(t *testing.T) {
+	var c Clock = SystemClock{}
+	start := c.Now()
+	got := <-c.After(time.Millisecond)
+	if got.Before(start) {
+		t.Errorf("After() received %v, want a time after %v", got, start)
+	}
+
+	timer := c.NewTimer(time.Millisecond)
+	if got := <-timer.C(); got.Before(start) {
+		t.Errorf("NewTimer() received %v, want a time after %v", got, start)
+	}
+	if timer.Stop() {
+		t.Errorf("Stop() of a fired timer = true, want false")
+	}
+	if !c.NewTimer(time.Hour).Stop() {
+		t.Errorf("Stop() = false, want true")
+	}
+}
+
+func TestStartJanitor(t *testing.T) {
+	c := NewManualClock(epoch)
+	sweeps := make(chan struct{})
+	stop := startJanitor(c, time.Minute, func() { sweeps <- struct{}{} })
+
+	for i := 0; i < 3; i++ {
+		c.BlockUntil(1)
+		c.Advance(time.Minute)
+		<-sweeps
+	}
+
+	stop()
+	stop()
+	if got := waiters(c); got != 0 {
+		t.Errorf("waiters after stop = %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,192 @@
+package collections
+
+import (
+	"iter"
+)
+
+// Collection is implemented by collections that hold a finite number of items
+// and can report their size, search for an item, copy their items to a slice
+// and remove all their items. It is modelled on .NET's ICollection<T>.
+type Collection[T any] interface {
+	// Size returns the number of items in the collection.
+	Size() int
+
+	// IsEmpty returns true if the collection is empty.
+	IsEmpty() bool
+
+	// Clear removes all items from the collection.
+	Clear()
+
+	// CopyTo copies the items in the collection to the given slice, starting
+	// at the given index.
+	CopyTo(items []T, index int) error
+
+	// Contains returns true if the collection contains the given item.
+	Contains(item T) bool
+}
+
+// ReadOnlyList is implemented by lists whose items can be read by index but
+// not modified. It is modelled on .NET's IReadOnlyList<T>.
+type ReadOnlyList[T any] interface {
+	// Size returns the number of items in the list.
+	Size() int
+
+	// IsEmpty returns true if the list is empty.
+	IsEmpty() bool
+
+	// Get returns the item at the given index.
+	Get(index int) (T, error)
+
+	// IndexOf returns the index of the given item, or -1 if it is not found.
+	IndexOf(item T) int
+
+	// Contains returns true if the list contains the given item.
+	Contains(item T) bool
+
+	// CopyTo copies the items in the list to the given slice, starting at the
+	// given index.
+	CopyTo(items []T, index int) error
+
+	// All returns an iterator over the indexes and items in the list.
+	All() iter.Seq2[int, T]
+
+	// Values returns an iterator over the items in the list.
+	Values() iter.Seq[T]
+
+	// String returns a string representation of the list.
+	String() string
+}
+
+// IList is implemented by lists whose items can be read, added, replaced and
+// removed by index. It is modelled on .NET's IList<T>.
+type IList[T any] interface {
+	Collection[T]
+	ReadOnlyList[T]
+
+	// Add adds an item to the end of the list.
+	Add(item T)
+
+	// Insert inserts an item at the given index.
+	Insert(index int, item T) error
+
+	// Set replaces the item at the given index.
+	Set(index int, item T) error
+
+	// Remove removes the first occurrence of the given item and returns true
+	// if it was found.
+	Remove(item T) bool
+
+	// RemoveAt removes the item at the given index.
+	RemoveAt(index int) error
+}
+
+// IQueue is implemented by every queue. Which item is at the front depends on
+// the queue: it is the oldest item for Queue and the smallest for
+// PriorityQueue. It does not include Enqueue, because thread-safe queues can
+// refuse an item and so return an error; code that only consumes a queue can
+// take an IQueue and accept either kind. Queues that always accept an item
+// implement IUnboundedQueue, and queues that can refuse one implement
+// IConcurrentQueue.
+type IQueue[T any] interface {
+	Collection[T]
+
+	// Dequeue removes and returns the item at the front of the queue.
+	Dequeue() (T, error)
+
+	// Peek returns the item at the front of the queue without removing it.
+	Peek() (T, error)
+}
+
+// IUnboundedQueue is implemented by queues that always accept an item.
+type IUnboundedQueue[T any] interface {
+	IQueue[T]
+
+	// Enqueue adds an item to the queue.
+	Enqueue(item T)
+}
+
+// IConcurrentQueue is implemented by thread-safe FIFO queues that can refuse
+// an item, such as a ConcurrentQueue that has been closed or is full.
+type IConcurrentQueue[T any] interface {
+	IQueue[T]
+
+	// Enqueue adds an item to the end of the queue, or returns an error if
+	// the queue cannot accept it.
+	Enqueue(item T) error
+}
+
+// IStack is implemented by every LIFO stack. Like IQueue, it does not include
+// Push; stacks that always accept an item implement IUnboundedStack, and
+// stacks that can refuse one implement IConcurrentStack.
+type IStack[T any] interface {
+	Collection[T]
+
+	// Pop removes and returns the item at the top of the stack.
+	Pop() (T, error)
+
+	// Peek returns the item at the top of the stack without removing it.
+	Peek() (T, error)
+}
+
+// IUnboundedStack is implemented by stacks that always accept an item.
+type IUnboundedStack[T any] interface {
+	IStack[T]
+
+	// Push adds an item to the top of the stack.
+	Push(item T)
+}
+
+// IConcurrentStack is implemented by thread-safe LIFO stacks that can refuse
+// an item, such as a ConcurrentStack that has been closed.
+type IConcurrentStack[T any] interface {
+	IStack[T]
+
+	// Push adds an item to the top of the stack, or returns an error if the
+	// stack cannot accept it.
+	Push(item T) error
+}
+
+// Sized is implemented by collections that can report their size. Every
+// Collection is Sized. WorkStealingDeque is Sized but not a Collection,
+// because its items can only be popped by the owner or stolen, not searched
+// or copied.
+type Sized interface {
+	// Size returns the number of items in the collection.
+	Size() int
+
+	// IsEmpty returns true if the collection is empty.
+	IsEmpty() bool
+}
+
+var (
+	_ IList[int] = (*List[int])(nil)
+	_ IList[int] = (*ConcurrentList[int])(nil)
+	_ IList[int] = (*ImmutableListBuilder[int])(nil)
+
+	_ ReadOnlyList[int] = (*ImmutableList[int])(nil)
+
+	_ IUnboundedQueue[int] = (*Queue[int])(nil)
+	_ IUnboundedQueue[int] = (*PriorityQueue[int])(nil)
+	_ IUnboundedQueue[int] = (*ConcurrentPriorityQueue[int])(nil)
+	_ IUnboundedQueue[int] = (*ExpiringQueue[int])(nil)
+	_ IUnboundedQueue[int] = (*ImmutableQueueBuilder[int])(nil)
+
+	_ IConcurrentQueue[int] = (*ConcurrentQueue[int])(nil)
+	_ IConcurrentQueue[int] = (*LockFreeQueue[int])(nil)
+	_ IConcurrentQueue[int] = (*BoundedLockFreeQueue[int])(nil)
+
+	_ IUnboundedStack[int] = (*Stack[int])(nil)
+	_ IUnboundedStack[int] = (*ImmutableStackBuilder[int])(nil)
+
+	_ IConcurrentStack[int] = (*ConcurrentStack[int])(nil)
+	_ IConcurrentStack[int] = (*LockFreeStack[int])(nil)
+
+	_ Collection[int] = (*Deque[int])(nil)
+	_ Collection[int] = (*ConcurrentDeque[int])(nil)
+	_ Collection[int] = (*SortedSet[int])(nil)
+	_ Collection[int] = (*HashSet[int])(nil)
+	_ Collection[int] = (*ConcurrentHashSet[int])(nil)
+	_ Collection[int] = (*LinkedList[int])(nil)
+
+	_ Sized = (*WorkStealingDeque[int])(nil)
+)
@@ -0,0 +1,210 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCollection(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Collection[int]
+		want []int
+	}{
+		{name: "List", c: NewList[int](1, 2, 3), want: []int{1, 2, 3}},
+		{name: "ConcurrentList", c: NewConcurrentList[int](1, 2, 3), want: []int{1, 2, 3}},
+		{name: "Queue", c: NewQueue[int](1, 2, 3), want: []int{1, 2, 3}},
+		{name: "ConcurrentQueue", c: NewConcurrentQueue[int](1, 2, 3), want: []int{1, 2, 3}},
+		{name: "Stack", c: NewStack[int](1, 2, 3), want: []int{3, 2, 1}},
+		{name: "ConcurrentStack", c: NewConcurrentStack[int](1, 2, 3), want: []int{3, 2, 1}},
+		{name: "Deque", c: NewDeque[int](1, 2, 3), want: []int{1, 2, 3}},
+		{name: "ConcurrentDeque", c: NewConcurrentDeque[int](1, 2, 3), want: []int{1, 2, 3}},
+		{name: "PriorityQueue", c: NewPriorityQueue[int](compareInts, 1, 2, 3), want: []int{1, 2, 3}},
+		{name: "ConcurrentPriorityQueue", c: NewConcurrentPriorityQueue[int](compareInts, 1, 2, 3), want: []int{1, 2, 3}},
+		{name: "SortedSet", c: NewSortedSet[int](compareInts, 3, 1, 2), want: []int{1, 2, 3}},
+		{name: "LinkedList", c: NewLinkedList[int](1, 2, 3), want: []int{1, 2, 3}},
+		{name: "ImmutableStackBuilder", c: NewImmutableStack[int](1, 2, 3).ToBuilder(), want: []int{3, 2, 1}},
+		{name: "ImmutableQueueBuilder", c: NewImmutableQueue[int](1, 2, 3).ToBuilder(), want: []int{1, 2, 3}},
+		{name: "LockFreeStack", c: NewLockFreeStack[int](1, 2, 3), want: []int{3, 2, 1}},
+		{name: "LockFreeQueue", c: NewLockFreeQueue[int](1, 2, 3), want: []int{1, 2, 3}},
+		{name: "BoundedLockFreeQueue", c: func() Collection[int] {
+			q := NewBoundedLockFreeQueue[int](4)
+			for i := 1; i <= 3; i++ {
+				_ = q.Enqueue(i)
+			}
+			return q
+		}(), want: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Size(); got != len(tt.want) {
+				t.Errorf("Size() = %v, want %v", got, len(tt.want))
+			}
+			if tt.c.IsEmpty() {
+				t.Errorf("IsEmpty() = true, want false")
+			}
+			if !tt.c.Contains(2) {
+				t.Errorf("Contains(2) = false, want true")
+			}
+			if tt.c.Contains(4) {
+				t.Errorf("Contains(4) = true, want false")
+			}
+
+			got := make([]int, len(tt.want)+1)
+			if err := tt.c.CopyTo(got, 1); err != nil {
+				t.Fatalf("CopyTo() error = %v", err)
+			}
+			if !reflect.DeepEqual(got[1:], tt.want) {
+				t.Errorf("CopyTo() = %v, want %v", got[1:], tt.want)
+			}
+			if err := tt.c.CopyTo(got, 2); err != ErrIndexOutOfRange {
+				t.Errorf("CopyTo() error = %v, want %v", err, ErrIndexOutOfRange)
+			}
+
+			tt.c.Clear()
+			if got := tt.c.Size(); got != 0 {
+				t.Errorf("Size() after Clear() = %v, want %v", got, 0)
+			}
+			if !tt.c.IsEmpty() {
+				t.Errorf("IsEmpty() after Clear() = false, want true")
+			}
+			if tt.c.Contains(2) {
+				t.Errorf("Contains(2) after Clear() = true, want false")
+			}
+		})
+	}
+}
+
+func TestCollection_EqualityComparer(t *testing.T) {
+	// Slices are not comparable, so these collections need a comparer.
+	compareFirst := func(a, b []int) int {
+		return a[0] - b[0]
+	}
+	comparer := func(a, b []int) bool {
+		return reflect.DeepEqual(a, b)
+	}
+	tests := []struct {
+		name string
+		c    Collection[[]int]
+	}{
+		{name: "List", c: NewListWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "Queue", c: NewQueueWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "ConcurrentQueue", c: NewConcurrentQueueWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "BoundedConcurrentQueue", c: func() Collection[[]int] {
+			q := NewBoundedConcurrentQueueWithEqualityComparer(2, comparer)
+			_ = q.Enqueue([]int{1})
+			_ = q.Enqueue([]int{2})
+			return q
+		}()},
+		{name: "Stack", c: NewStackWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "ConcurrentStack", c: NewConcurrentStackWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "PriorityQueue", c: NewPriorityQueueWithEqualityComparer(compareFirst, comparer, []int{1}, []int{2})},
+		{name: "ConcurrentPriorityQueue", c: NewConcurrentPriorityQueueWithEqualityComparer(compareFirst, comparer, []int{1}, []int{2})},
+		{name: "Deque", c: NewDequeWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "ConcurrentDeque", c: NewConcurrentDequeWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "LinkedList", c: NewLinkedListWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "LockFreeStack", c: NewLockFreeStackWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "LockFreeQueue", c: NewLockFreeQueueWithEqualityComparer(comparer, []int{1}, []int{2})},
+		{name: "HashSet", c: NewHashSetWithEqualityComparer(comparer, hashInts, []int{1}, []int{2})},
+		{name: "ConcurrentHashSet", c: NewConcurrentHashSetWithEqualityComparer(comparer, hashInts, []int{1}, []int{2})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.c.Contains([]int{2}) {
+				t.Errorf("Contains([2]) = false, want true")
+			}
+			if tt.c.Contains([]int{3}) {
+				t.Errorf("Contains([3]) = true, want false")
+			}
+		})
+	}
+}
+
+// enqueue adds an item to a queue, whether or not the queue can refuse it.
+func enqueue(t *testing.T, q IQueue[int], item int) {
+	t.Helper()
+	switch q := q.(type) {
+	case IUnboundedQueue[int]:
+		q.Enqueue(item)
+	case IConcurrentQueue[int]:
+		if err := q.Enqueue(item); err != nil {
+			t.Fatalf("Enqueue(%v) error = %v", item, err)
+		}
+	default:
+		t.Fatalf("%T does not have Enqueue", q)
+	}
+}
+
+// push pushes an item onto a stack, whether or not the stack can refuse it.
+func push(t *testing.T, s IStack[int], item int) {
+	t.Helper()
+	switch s := s.(type) {
+	case IUnboundedStack[int]:
+		s.Push(item)
+	case IConcurrentStack[int]:
+		if err := s.Push(item); err != nil {
+			t.Fatalf("Push(%v) error = %v", item, err)
+		}
+	default:
+		t.Fatalf("%T does not have Push", s)
+	}
+}
+
+func TestIQueue(t *testing.T) {
+	queues := map[string]IQueue[int]{
+		"Queue":                   NewQueue[int](),
+		"PriorityQueue":           NewPriorityQueue[int](compareInts),
+		"ConcurrentPriorityQueue": NewConcurrentPriorityQueue[int](compareInts),
+		"ExpiringQueue":           NewExpiringQueue[int](time.Hour, NewManualClock(epoch)),
+		"ImmutableQueueBuilder":   NewImmutableQueue[int]().ToBuilder(),
+		"ConcurrentQueue":         NewConcurrentQueue[int](),
+		"LockFreeQueue":           NewLockFreeQueue[int](),
+		"BoundedLockFreeQueue":    NewBoundedLockFreeQueue[int](4),
+	}
+	for name, q := range queues {
+		t.Run(name, func(t *testing.T) {
+			for i := 1; i <= 3; i++ {
+				enqueue(t, q, i)
+			}
+			for want := 1; want <= 3; want++ {
+				if got, err := q.Peek(); got != want || err != nil {
+					t.Errorf("Peek() = %v, %v, want %v, %v", got, err, want, nil)
+				}
+				if got, err := q.Dequeue(); got != want || err != nil {
+					t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, want, nil)
+				}
+			}
+			if _, err := q.Dequeue(); err != ErrEmptyQueue {
+				t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+			}
+		})
+	}
+}
+
+func TestIStack(t *testing.T) {
+	stacks := map[string]IStack[int]{
+		"Stack":                 NewStack[int](),
+		"ImmutableStackBuilder": NewImmutableStack[int]().ToBuilder(),
+		"ConcurrentStack":       NewConcurrentStack[int](),
+		"LockFreeStack":         NewLockFreeStack[int](),
+	}
+	for name, s := range stacks {
+		t.Run(name, func(t *testing.T) {
+			for i := 1; i <= 3; i++ {
+				push(t, s, i)
+			}
+			for want := 3; want >= 1; want-- {
+				if got, err := s.Peek(); got != want || err != nil {
+					t.Errorf("Peek() = %v, %v, want %v, %v", got, err, want, nil)
+				}
+				if got, err := s.Pop(); got != want || err != nil {
+					t.Errorf("Pop() = %v, %v, want %v, %v", got, err, want, nil)
+				}
+			}
+			if _, err := s.Pop(); err != ErrEmptyStack {
+				t.Errorf("Pop() error = %v, want %v", err, ErrEmptyStack)
+			}
+		})
+	}
+}
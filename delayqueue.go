@@ -0,0 +1,218 @@
+package collections
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// delayedItem is an item in a DelayQueue, the time at which it becomes ready,
+// and the order in which it was enqueued.
+type delayedItem[T any] struct {
+	item    T
+	readyAt time.Time
+	seq     uint64
+}
+
+// compareDelayedItems returns a comparer that orders items by the time at
+// which they become ready, then by the given comparer if it is not nil, and
+// then by the order in which they were enqueued.
+func compareDelayedItems[T any](comparer Comparer[T]) Comparer[delayedItem[T]] {
+	return func(a, b delayedItem[T]) int {
+		if c := a.readyAt.Compare(b.readyAt); c != 0 {
+			return c
+		}
+		if comparer != nil {
+			if c := comparer(a.item, b.item); c != 0 {
+				return c
+			}
+		}
+		return compareSeq(a.seq, b.seq)
+	}
+}
+
+// compareSeq orders two sequence numbers.
+func compareSeq(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// DelayQueue implements a queue in which each item is hidden until the time at
+// which it becomes ready. Items are dequeued in the order in which they become
+// ready, and items that become ready at the same time are dequeued in the
+// order in which they were enqueued, unless a comparer is given to
+// NewDelayQueueWithComparer. It is backed by a binary heap, so
+// enqueuing and dequeuing take O(log n) time. Time is measured by a Clock, so
+// that tests can control it. It is thread-safe.
+type DelayQueue[T any] struct {
+	items    []delayedItem[T]
+	seq      uint64
+	clock    Clock
+	comparer Comparer[delayedItem[T]]
+	changed  signal
+	mutex    sync.Mutex
+}
+
+// NewDelayQueue returns a new, empty queue that uses the given clock to tell
+// when items are ready. If clock is nil, SystemClock is used.
+func NewDelayQueue[T any](clock Clock) *DelayQueue[T] {
+	return NewDelayQueueWithComparer[T](clock, nil)
+}
+
+// NewDelayQueueWithComparer returns a new, empty queue that uses the given
+// clock to tell when items are ready, and the given comparer to order items
+// that become ready at the same time. Items that the comparer considers equal
+// are dequeued in the order in which they were enqueued. If clock is nil,
+// SystemClock is used.
+func NewDelayQueueWithComparer[T any](clock Clock, comparer Comparer[T]) *DelayQueue[T] {
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	return &DelayQueue[T]{clock: clock, comparer: compareDelayedItems(comparer)}
+}
+
+// Enqueue adds an item to the queue that becomes ready at the given time. If
+// the time has already passed, the item is ready immediately.
+func (q *DelayQueue[T]) Enqueue(item T, readyAt time.Time) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.items = append(q.items, delayedItem[T]{item: item, readyAt: readyAt, seq: q.seq})
+	q.seq++
+	heapUp(q.items, len(q.items)-1, q.comparer)
+
+	// Only an item that becomes ready before every other item changes how
+	// long consumers must wait.
+	if q.items[0].seq == q.seq-1 {
+		q.changed.broadcast()
+	}
+}
+
+// EnqueueAfter adds an item to the queue that becomes ready once the given
+// delay has passed.
+func (q *DelayQueue[T]) EnqueueAfter(item T, delay time.Duration) {
+	q.Enqueue(item, q.clock.Now().Add(delay))
+}
+
+// Dequeue removes and returns the item that became ready first. If no item is
+// ready, ErrEmptyQueue is returned, even if the queue holds items that are not
+// ready yet.
+func (q *DelayQueue[T]) Dequeue() (T, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if !q.ready(q.clock.Now()) {
+		var zero T
+		return zero, ErrEmptyQueue
+	}
+	return q.pop(), nil
+}
+
+// DequeueContext removes and returns the item that became ready first. If no
+// item is ready, DequeueContext blocks until one is or the context is done, in
+// which case the context's error is returned.
+func (q *DelayQueue[T]) DequeueContext(ctx context.Context) (T, error) {
+	for {
+		q.mutex.Lock()
+		now := q.clock.Now()
+		if q.ready(now) {
+			item := q.pop()
+			q.mutex.Unlock()
+			return item, nil
+		}
+
+		var timer Timer
+		var fired <-chan time.Time
+		if len(q.items) > 0 {
+			timer = q.clock.NewTimer(q.items[0].readyAt.Sub(now))
+			fired = timer.C()
+		}
+		ch := q.changed.wait()
+		q.mutex.Unlock()
+
+		var err error
+		select {
+		case <-ch:
+		case <-fired:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
+
+// Peek returns the item that became ready first without removing it. If no
+// item is ready, ErrEmptyQueue is returned.
+func (q *DelayQueue[T]) Peek() (T, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if !q.ready(q.clock.Now()) {
+		var zero T
+		return zero, ErrEmptyQueue
+	}
+	return q.items[0].item, nil
+}
+
+// PeekNextReadyTime returns the time at which the next item becomes ready, and
+// true. The time may have passed, if an item is already ready. If the queue is
+// empty, false is returned.
+func (q *DelayQueue[T]) PeekNextReadyTime() (time.Time, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.items) == 0 {
+		return time.Time{}, false
+	}
+	return q.items[0].readyAt, true
+}
+
+// IsEmpty returns true if the queue is empty, including items that are not
+// ready yet.
+func (q *DelayQueue[T]) IsEmpty() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.items) == 0
+}
+
+// Size returns the number of items in the queue, including items that are not
+// ready yet.
+func (q *DelayQueue[T]) Size() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.items)
+}
+
+// Clear removes all items from the queue.
+func (q *DelayQueue[T]) Clear() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.items = nil
+	q.changed.broadcast()
+}
+
+// ready returns true if the queue has an item that is ready at the given time.
+// The caller must hold the lock.
+func (q *DelayQueue[T]) ready(now time.Time) bool {
+	return len(q.items) > 0 && !now.Before(q.items[0].readyAt)
+}
+
+// pop removes and returns the item that becomes ready first. The caller must
+// hold the lock and the queue must not be empty.
+func (q *DelayQueue[T]) pop() T {
+	var d delayedItem[T]
+	q.items, d = heapPop(q.items, q.comparer)
+	return d.item
+}
@@ -0,0 +1,250 @@
+package collections
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDelayQueue_Dequeue(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewDelayQueue[string](c)
+
+	if _, ok := q.PeekNextReadyTime(); ok {
+		t.Errorf("PeekNextReadyTime() of an empty queue = true, want false")
+	}
+
+	q.EnqueueAfter("c", 3*time.Second)
+	q.EnqueueAfter("a", time.Second)
+	q.EnqueueAfter("b", 2*time.Second)
+	q.EnqueueAfter("b2", 2*time.Second)
+
+	if got := q.Size(); got != 4 {
+		t.Errorf("Size() = %v, want %v", got, 4)
+	}
+	if got, ok := q.PeekNextReadyTime(); !ok || !got.Equal(epoch.Add(time.Second)) {
+		t.Errorf("PeekNextReadyTime() = %v, %v, want %v, %v", got, ok, epoch.Add(time.Second), true)
+	}
+	if _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() before ready error = %v, want %v", err, ErrEmptyQueue)
+	}
+	if _, err := q.Peek(); err != ErrEmptyQueue {
+		t.Errorf("Peek() before ready error = %v, want %v", err, ErrEmptyQueue)
+	}
+
+	c.Advance(2 * time.Second)
+	if got, err := q.Peek(); got != "a" || err != nil {
+		t.Errorf("Peek() = %v, %v, want %v, %v", got, err, "a", nil)
+	}
+
+	// Items that become ready at the same time are dequeued in order.
+	for _, want := range []string{"a", "b", "b2"} {
+		if got, err := q.Dequeue(); got != want || err != nil {
+			t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, want, nil)
+		}
+	}
+	if _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	if q.IsEmpty() {
+		t.Errorf("IsEmpty() = true, want false")
+	}
+
+	// An item whose time has passed is ready immediately.
+	q.Enqueue("past", epoch)
+	if got, err := q.Dequeue(); got != "past" || err != nil {
+		t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, "past", nil)
+	}
+
+	q.Clear()
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() after Clear() = false, want true")
+	}
+}
+
+func TestDelayQueue_DequeueContext(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewDelayQueue[int](c)
+	q.EnqueueAfter(1, time.Minute)
+
+	result := make(chan int)
+	go func() {
+		item, err := q.DequeueContext(context.Background())
+		if err != nil {
+			t.Errorf("DequeueContext() error = %v", err)
+		}
+		result <- item
+	}()
+
+	// The consumer waits for the item to become ready.
+	c.BlockUntil(1)
+	select {
+	case item := <-result:
+		t.Fatalf("DequeueContext() = %v before the item was ready", item)
+	default:
+	}
+	c.Advance(time.Minute)
+	if got := <-result; got != 1 {
+		t.Errorf("DequeueContext() = %v, want %v", got, 1)
+	}
+}
+
+func TestDelayQueue_DequeueContext_Enqueue(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewDelayQueue[int](c)
+
+	result := make(chan int)
+	go func() {
+		item, _ := q.DequeueContext(context.Background())
+		result <- item
+	}()
+
+	// A consumer blocked on an empty queue, or on an item that becomes ready
+	// later, is woken by an item that becomes ready sooner.
+	q.EnqueueAfter(1, time.Hour)
+	c.BlockUntil(1)
+	q.EnqueueAfter(2, 0)
+	if got := <-result; got != 2 {
+		t.Errorf("DequeueContext() = %v, want %v", got, 2)
+	}
+	if got, ok := q.PeekNextReadyTime(); !ok || !got.Equal(epoch.Add(time.Hour)) {
+		t.Errorf("PeekNextReadyTime() = %v, %v, want %v, %v", got, ok, epoch.Add(time.Hour), true)
+	}
+}
+
+func TestDelayQueue_DequeueContext_Cancel(t *testing.T) {
+	q := NewDelayQueue[int](NewManualClock(epoch))
+	q.EnqueueAfter(1, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := q.DequeueContext(ctx); err != context.Canceled {
+		t.Errorf("DequeueContext() error = %v, want %v", err, context.Canceled)
+	}
+	if got := q.Size(); got != 1 {
+		t.Errorf("Size() = %v, want %v", got, 1)
+	}
+}
+
+func TestDelayQueue_DequeueContext_StopsTimers(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewDelayQueue[int](c)
+	q.EnqueueAfter(0, time.Hour)
+
+	// Each wakeup and each cancellation leaves no timer waiting on the clock.
+	result := make(chan int)
+	go func() {
+		item, _ := q.DequeueContext(context.Background())
+		result <- item
+	}()
+	for i := 1; i <= 10; i++ {
+		c.BlockUntil(1)
+		q.EnqueueAfter(i, time.Duration(20-i)*time.Minute)
+	}
+	c.Advance(10 * time.Minute)
+	if got := <-result; got != 10 {
+		t.Errorf("DequeueContext() = %v, want %v", got, 10)
+	}
+	if got := waiters(c); got != 0 {
+		t.Errorf("waiters after 10 wakeups = %v, want %v", got, 0)
+	}
+
+	q.Clear()
+	q.EnqueueAfter(0, time.Hour)
+
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			_, _ = q.DequeueContext(ctx)
+			close(done)
+		}()
+		c.BlockUntil(1)
+		cancel()
+		<-done
+	}
+	if got := waiters(c); got != 0 {
+		t.Errorf("waiters after 10 cancellations = %v, want %v", got, 0)
+	}
+}
+
+func TestDelayQueue_Comparer(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewDelayQueueWithComparer(c, func(a, b string) int { return len(a) - len(b) })
+	for _, item := range []string{"ccc", "a", "bb", "b"} {
+		q.EnqueueAfter(item, time.Second)
+	}
+	q.EnqueueAfter("early", 0)
+
+	// The comparer orders items that become ready at the same time, and items
+	// that it considers equal are dequeued in the order they were enqueued.
+	c.Advance(time.Second)
+	for _, want := range []string{"early", "a", "b", "bb", "ccc"} {
+		if got, err := q.Dequeue(); got != want || err != nil {
+			t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, want, nil)
+		}
+	}
+}
+
+func TestDelayQueue_SystemClock(t *testing.T) {
+	q := NewDelayQueue[int](nil)
+	const producers, perProducer = 4, 50
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.EnqueueAfter(p*perProducer+i, time.Duration(i%5)*time.Millisecond)
+			}
+		}(p)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	seen := make(map[int]bool)
+	for len(seen) < producers*perProducer {
+		item, err := q.DequeueContext(ctx)
+		if err != nil {
+			t.Fatalf("DequeueContext() error = %v after %v items", err, len(seen))
+		}
+		if seen[item] {
+			t.Fatalf("DequeueContext() returned %v twice", item)
+		}
+		seen[item] = true
+	}
+	wg.Wait()
+
+	if q.Size() != 0 {
+		t.Errorf("Size() = %v, want %v", q.Size(), 0)
+	}
+}
+
+func BenchmarkDelayQueue_EnqueueDequeue(b *testing.B) {
+	q := NewDelayQueue[int](NewManualClock(epoch))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i, epoch)
+		_, _ = q.Dequeue()
+	}
+}
+
+func ExampleDelayQueue() {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	q := NewDelayQueue[string](clock)
+	q.EnqueueAfter("retry", 5*time.Second)
+
+	_, err := q.Dequeue()
+	fmt.Println(err)
+
+	readyAt, _ := q.PeekNextReadyTime()
+	clock.Set(readyAt)
+	item, _ := q.Dequeue()
+	fmt.Println(item)
+	// Output:
+	// queue is empty
+	// retry
+}
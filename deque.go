@@ -0,0 +1,358 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"sync"
+)
+
+// Deque implements a double-ended queue that supports adding and removing
+// items at both ends, and reading and writing items by index, in constant
+// time. The items are stored in a ring buffer. It is not thread-safe.
+type Deque[T any] struct {
+	items    ring[T]
+	comparer EqualityComparer[T]
+}
+
+// NewDeque returns a new deque with the given initial items, from front to
+// back.
+func NewDeque[T comparable](values ...T) *Deque[T] {
+	return &Deque[T]{items: newRing(values), comparer: DefaultEqualityComparer[T]}
+}
+
+// NewDequeWithEqualityComparer returns a new deque with the given initial
+// items, from front to back, that uses the given comparer to find items.
+func NewDequeWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *Deque[T] {
+	return &Deque[T]{items: newRing(values), comparer: comparer}
+}
+
+// PushFront adds an item to the front of the deque.
+func (d *Deque[T]) PushFront(item T) {
+	d.items.pushFront(item)
+}
+
+// PushBack adds an item to the back of the deque.
+func (d *Deque[T]) PushBack(item T) {
+	d.items.pushBack(item)
+}
+
+// PopFront removes and returns the item at the front of the deque. If the
+// deque is empty, an error is returned.
+func (d *Deque[T]) PopFront() (T, error) {
+	var zero T
+	if d.items.len() == 0 {
+		return zero, ErrEmptyDeque
+	}
+	return d.items.popFront(), nil
+}
+
+// PopBack removes and returns the item at the back of the deque. If the deque
+// is empty, an error is returned.
+func (d *Deque[T]) PopBack() (T, error) {
+	var zero T
+	if d.items.len() == 0 {
+		return zero, ErrEmptyDeque
+	}
+	return d.items.popBack(), nil
+}
+
+// PeekFront returns the item at the front of the deque without removing it.
+// If the deque is empty, an error is returned.
+func (d *Deque[T]) PeekFront() (T, error) {
+	var zero T
+	if d.items.len() == 0 {
+		return zero, ErrEmptyDeque
+	}
+	return d.items.front(), nil
+}
+
+// PeekBack returns the item at the back of the deque without removing it. If
+// the deque is empty, an error is returned.
+func (d *Deque[T]) PeekBack() (T, error) {
+	var zero T
+	if d.items.len() == 0 {
+		return zero, ErrEmptyDeque
+	}
+	return d.items.back(), nil
+}
+
+// Get returns the item at the given index, counting from the front.
+func (d *Deque[T]) Get(index int) (T, error) {
+	var zero T
+	if index < 0 || index >= d.items.len() {
+		return zero, ErrIndexOutOfRange
+	}
+	return d.items.at(index), nil
+}
+
+// Set sets the item at the given index, counting from the front.
+func (d *Deque[T]) Set(index int, item T) error {
+	if index < 0 || index >= d.items.len() {
+		return ErrIndexOutOfRange
+	}
+	d.items.set(index, item)
+	return nil
+}
+
+// Rotate moves the first n items to the back of the deque, so that the item
+// at index n becomes the front. A negative n moves the last -n items to the
+// front instead.
+func (d *Deque[T]) Rotate(n int) {
+	d.items.rotate(n)
+}
+
+// IsEmpty returns true if the deque is empty.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.items.len() == 0
+}
+
+// Size returns the number of items in the deque.
+func (d *Deque[T]) Size() int {
+	return d.items.len()
+}
+
+// Values returns an iterator over the items in the deque, from front to back.
+// The deque should not be modified during iteration.
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.items.len(); i++ {
+			if !yield(d.items.at(i)) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the deque.
+func (d *Deque[T]) String() string {
+	return fmt.Sprintf("%v", d.items.slice())
+}
+
+// Contains returns true if the deque contains the given item.
+func (d *Deque[T]) Contains(item T) bool {
+	return d.items.contains(item, d.comparer)
+}
+
+// Clear removes all items from the deque.
+func (d *Deque[T]) Clear() {
+	d.items.clear()
+}
+
+// CopyTo copies the items in the deque, from front to back, to the given
+// slice, starting at the given index. If the index is out of range, an error
+// is returned. If the slice is not large enough to hold all the items, an
+// error is returned.
+func (d *Deque[T]) CopyTo(items []T, index int) error {
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < d.items.len() {
+		return ErrIndexOutOfRange
+	}
+
+	d.items.copyTo(items[index:])
+
+	return nil
+}
+
+// ConcurrentDeque implements a double-ended queue. It is thread-safe.
+type ConcurrentDeque[T any] struct {
+	items    ring[T]
+	comparer EqualityComparer[T]
+	mutex    sync.RWMutex
+}
+
+// NewConcurrentDeque returns a new deque with the given initial items, from
+// front to back.
+func NewConcurrentDeque[T comparable](values ...T) *ConcurrentDeque[T] {
+	return &ConcurrentDeque[T]{items: newRing(values), comparer: DefaultEqualityComparer[T]}
+}
+
+// NewConcurrentDequeWithEqualityComparer returns a new deque with the given
+// initial items, from front to back, that uses the given comparer to find
+// items.
+func NewConcurrentDequeWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *ConcurrentDeque[T] {
+	return &ConcurrentDeque[T]{items: newRing(values), comparer: comparer}
+}
+
+// PushFront adds an item to the front of the deque.
+func (d *ConcurrentDeque[T]) PushFront(item T) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.items.pushFront(item)
+}
+
+// PushBack adds an item to the back of the deque.
+func (d *ConcurrentDeque[T]) PushBack(item T) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.items.pushBack(item)
+}
+
+// PopFront removes and returns the item at the front of the deque. If the
+// deque is empty, an error is returned.
+func (d *ConcurrentDeque[T]) PopFront() (T, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	var zero T
+	if d.items.len() == 0 {
+		return zero, ErrEmptyDeque
+	}
+	return d.items.popFront(), nil
+}
+
+// PopBack removes and returns the item at the back of the deque. If the deque
+// is empty, an error is returned.
+func (d *ConcurrentDeque[T]) PopBack() (T, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	var zero T
+	if d.items.len() == 0 {
+		return zero, ErrEmptyDeque
+	}
+	return d.items.popBack(), nil
+}
+
+// PeekFront returns the item at the front of the deque without removing it.
+// If the deque is empty, an error is returned.
+func (d *ConcurrentDeque[T]) PeekFront() (T, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	var zero T
+	if d.items.len() == 0 {
+		return zero, ErrEmptyDeque
+	}
+	return d.items.front(), nil
+}
+
+// PeekBack returns the item at the back of the deque without removing it. If
+// the deque is empty, an error is returned.
+func (d *ConcurrentDeque[T]) PeekBack() (T, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	var zero T
+	if d.items.len() == 0 {
+		return zero, ErrEmptyDeque
+	}
+	return d.items.back(), nil
+}
+
+// Get returns the item at the given index, counting from the front.
+func (d *ConcurrentDeque[T]) Get(index int) (T, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	var zero T
+	if index < 0 || index >= d.items.len() {
+		return zero, ErrIndexOutOfRange
+	}
+	return d.items.at(index), nil
+}
+
+// Set sets the item at the given index, counting from the front.
+func (d *ConcurrentDeque[T]) Set(index int, item T) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if index < 0 || index >= d.items.len() {
+		return ErrIndexOutOfRange
+	}
+	d.items.set(index, item)
+	return nil
+}
+
+// Rotate moves the first n items to the back of the deque, so that the item
+// at index n becomes the front. A negative n moves the last -n items to the
+// front instead.
+func (d *ConcurrentDeque[T]) Rotate(n int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.items.rotate(n)
+}
+
+// IsEmpty returns true if the deque is empty.
+func (d *ConcurrentDeque[T]) IsEmpty() bool {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.items.len() == 0
+}
+
+// Size returns the number of items in the deque.
+func (d *ConcurrentDeque[T]) Size() int {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.items.len()
+}
+
+// Values returns an iterator over the items in the deque, from front to back.
+// The iterator works on a snapshot of the deque taken when iteration starts,
+// so the lock is not held while the loop body runs and changes made to the
+// deque during iteration are not visible to the iterator.
+func (d *ConcurrentDeque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		d.mutex.RLock()
+		items := d.items.slice()
+		d.mutex.RUnlock()
+
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the deque.
+func (d *ConcurrentDeque[T]) String() string {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return fmt.Sprintf("%v", d.items.slice())
+}
+
+// Contains returns true if the deque contains the given item.
+func (d *ConcurrentDeque[T]) Contains(item T) bool {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.items.contains(item, d.comparer)
+}
+
+// Clear removes all items from the deque.
+func (d *ConcurrentDeque[T]) Clear() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.items.clear()
+}
+
+// CopyTo copies the items in the deque, from front to back, to the given
+// slice, starting at the given index. If the index is out of range, an error
+// is returned. If the slice is not large enough to hold all the items, an
+// error is returned.
+func (d *ConcurrentDeque[T]) CopyTo(items []T, index int) error {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < d.items.len() {
+		return ErrIndexOutOfRange
+	}
+
+	d.items.copyTo(items[index:])
+
+	return nil
+}
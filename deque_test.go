@@ -0,0 +1,392 @@
+package collections
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestNewDeque(t *testing.T) {
+	d := NewDeque[string]("A", "B", "C")
+	if got, want := d.items.slice(), []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NewDeque() = %v, want %v", got, want)
+	}
+}
+
+func TestDeque_PushPop(t *testing.T) {
+	d := NewDeque[int]()
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+
+	if got, err := d.PeekFront(); got != 1 || err != nil {
+		t.Errorf("PeekFront() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if got, err := d.PeekBack(); got != 3 || err != nil {
+		t.Errorf("PeekBack() = %v, %v, want %v, %v", got, err, 3, nil)
+	}
+	if got, err := d.PopFront(); got != 1 || err != nil {
+		t.Errorf("PopFront() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if got, err := d.PopBack(); got != 3 || err != nil {
+		t.Errorf("PopBack() = %v, %v, want %v, %v", got, err, 3, nil)
+	}
+	if got := d.Size(); got != 1 {
+		t.Errorf("Size() = %v, want %v", got, 1)
+	}
+}
+
+func TestDeque_Empty(t *testing.T) {
+	d := NewDeque[int]()
+	if !d.IsEmpty() {
+		t.Errorf("IsEmpty() = false, want true")
+	}
+	if _, err := d.PopFront(); err != ErrEmptyDeque {
+		t.Errorf("PopFront() error = %v, want %v", err, ErrEmptyDeque)
+	}
+	if _, err := d.PopBack(); err != ErrEmptyDeque {
+		t.Errorf("PopBack() error = %v, want %v", err, ErrEmptyDeque)
+	}
+	if _, err := d.PeekFront(); err != ErrEmptyDeque {
+		t.Errorf("PeekFront() error = %v, want %v", err, ErrEmptyDeque)
+	}
+	if _, err := d.PeekBack(); err != ErrEmptyDeque {
+		t.Errorf("PeekBack() error = %v, want %v", err, ErrEmptyDeque)
+	}
+}
+
+func TestDeque_Get(t *testing.T) {
+	type testCase[T any] struct {
+		name    string
+		d       *Deque[T]
+		index   int
+		want    T
+		wantErr bool
+	}
+	wrapped := NewDeque[string]("B", "C")
+	wrapped.PushFront("A")
+	tests := []testCase[string]{
+		{name: "Normal", d: NewDeque[string]("A", "B", "C"), index: 1, want: "B", wantErr: false},
+		{name: "Wrapped", d: wrapped, index: 0, want: "A", wantErr: false},
+		{name: "IndexOutOfRange", d: NewDeque[string]("A", "B", "C"), index: 3, want: "", wantErr: true},
+		{name: "NegativeIndex", d: NewDeque[string]("A", "B", "C"), index: -1, want: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.d.Get(tt.index)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Get() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeque_Set(t *testing.T) {
+	type testCase[T any] struct {
+		name    string
+		d       *Deque[T]
+		index   int
+		want    []T
+		wantErr bool
+	}
+	tests := []testCase[string]{
+		{name: "Normal", d: NewDeque[string]("A", "B", "C"), index: 1, want: []string{"A", "X", "C"}, wantErr: false},
+		{name: "IndexOutOfRange", d: NewDeque[string]("A", "B", "C"), index: 3, want: []string{"A", "B", "C"}, wantErr: true},
+		{name: "NegativeIndex", d: NewDeque[string]("A", "B", "C"), index: -1, want: []string{"A", "B", "C"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.d.Set(tt.index, "X"); (err != nil) != tt.wantErr {
+				t.Errorf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := tt.d.items.slice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Set() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeque_Rotate(t *testing.T) {
+	d := NewDeque[int](1, 2, 3, 4)
+	d.Rotate(1)
+	if got := d.String(); got != "[2 3 4 1]" {
+		t.Errorf("Rotate(1) = %v, want %v", got, "[2 3 4 1]")
+	}
+	d.Rotate(-2)
+	if got := d.String(); got != "[4 1 2 3]" {
+		t.Errorf("Rotate(-2) = %v, want %v", got, "[4 1 2 3]")
+	}
+}
+
+func TestDeque_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := NewDeque[int]()
+	var model []int
+
+	for i := 0; i < 5000; i++ {
+		switch r.Intn(6) {
+		case 0:
+			d.PushFront(i)
+			model = append([]int{i}, model...)
+		case 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case 2:
+			got, err := d.PopFront()
+			if len(model) == 0 {
+				if err != ErrEmptyDeque {
+					t.Fatalf("PopFront() error = %v, want %v", err, ErrEmptyDeque)
+				}
+				continue
+			}
+			if got != model[0] {
+				t.Fatalf("PopFront() = %v, want %v", got, model[0])
+			}
+			model = model[1:]
+		case 3:
+			got, err := d.PopBack()
+			if len(model) == 0 {
+				if err != ErrEmptyDeque {
+					t.Fatalf("PopBack() error = %v, want %v", err, ErrEmptyDeque)
+				}
+				continue
+			}
+			if got != model[len(model)-1] {
+				t.Fatalf("PopBack() = %v, want %v", got, model[len(model)-1])
+			}
+			model = model[:len(model)-1]
+		case 4:
+			if len(model) == 0 {
+				continue
+			}
+			n := r.Intn(2*len(model)) - len(model)
+			d.Rotate(n)
+			k := ((n % len(model)) + len(model)) % len(model)
+			model = append(append([]int{}, model[k:]...), model[:k]...)
+		case 5:
+			if len(model) == 0 {
+				continue
+			}
+			j := r.Intn(len(model))
+			_ = d.Set(j, -i)
+			model[j] = -i
+		}
+
+		if got := d.items.slice(); !reflect.DeepEqual(got, append([]int{}, model...)) {
+			t.Fatalf("step %d: deque = %v, want %v", i, got, model)
+		}
+	}
+}
+
+func TestDeque_Values(t *testing.T) {
+	d := NewDeque[int](2, 3)
+	d.PushFront(1)
+
+	var got []int
+	for v := range d.Values() {
+		got = append(got, v)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestDeque_Clear(t *testing.T) {
+	d := NewDeque[int](1, 2, 3)
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Errorf("Clear() left %v items", d.Size())
+	}
+}
+
+func TestDeque_CopyTo(t *testing.T) {
+	type args[T any] struct {
+		items []T
+		index int
+	}
+	type testCase[T any] struct {
+		name    string
+		d       *Deque[T]
+		args    args[T]
+		want    []T
+		wantErr bool
+	}
+	tests := []testCase[string]{
+		{
+			name:    "Normal",
+			d:       NewDeque[string]("A", "B", "C"),
+			args:    args[string]{items: make([]string, 4), index: 1},
+			want:    []string{"", "A", "B", "C"},
+			wantErr: false,
+		},
+		{
+			name:    "IndexOutOfRange",
+			d:       NewDeque[string]("A", "B", "C"),
+			args:    args[string]{items: make([]string, 3), index: 4},
+			want:    []string{"", "", ""},
+			wantErr: true,
+		},
+		{
+			name:    "DestinationTooSmall",
+			d:       NewDeque[string]("A", "B", "C"),
+			args:    args[string]{items: make([]string, 2), index: 0},
+			want:    []string{"", ""},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.d.CopyTo(tt.args.items, tt.args.index); (err != nil) != tt.wantErr {
+				t.Errorf("CopyTo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.args.items, tt.want) {
+				t.Errorf("CopyTo() got = %v, want %v", tt.args.items, tt.want)
+			}
+		})
+	}
+}
+
+func TestConcurrentDeque(t *testing.T) {
+	d := NewConcurrentDeque[int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				d.PushFront(i)
+			} else {
+				d.PushBack(i)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := d.Size(); got != 100 {
+		t.Fatalf("Size() = %v, want %v", got, 100)
+	}
+
+	results := make(chan int, 100)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for {
+				var item int
+				var err error
+				if i%2 == 0 {
+					item, err = d.PopFront()
+				} else {
+					item, err = d.PopBack()
+				}
+				if err != nil {
+					return
+				}
+				results <- item
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(results)
+
+	seen := make(map[int]bool)
+	for item := range results {
+		seen[item] = true
+	}
+	if len(seen) != 100 {
+		t.Errorf("popped %v distinct items, want %v", len(seen), 100)
+	}
+}
+
+func TestConcurrentDeque_Methods(t *testing.T) {
+	d := NewConcurrentDeque[string]("A", "B", "C")
+
+	if got, _ := d.PeekFront(); got != "A" {
+		t.Errorf("PeekFront() = %v, want %v", got, "A")
+	}
+	if got, _ := d.PeekBack(); got != "C" {
+		t.Errorf("PeekBack() = %v, want %v", got, "C")
+	}
+	if err := d.Set(1, "X"); err != nil {
+		t.Errorf("Set() error = %v", err)
+	}
+	if got, _ := d.Get(1); got != "X" {
+		t.Errorf("Get() = %v, want %v", got, "X")
+	}
+	if _, err := d.Get(3); err != ErrIndexOutOfRange {
+		t.Errorf("Get() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if err := d.Set(-1, "Y"); err != ErrIndexOutOfRange {
+		t.Errorf("Set() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	d.Rotate(1)
+	if got := d.String(); got != "[X C A]" {
+		t.Errorf("String() = %v, want %v", got, "[X C A]")
+	}
+
+	var got []string
+	for v := range d.Values() {
+		d.PushBack(v)
+		got = append(got, v)
+	}
+	if want := []string{"X", "C", "A"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+
+	items := make([]string, 6)
+	if err := d.CopyTo(items, 0); err != nil {
+		t.Errorf("CopyTo() error = %v", err)
+	}
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Errorf("Clear() left %v items", d.Size())
+	}
+	if _, err := d.PopFront(); err != ErrEmptyDeque {
+		t.Errorf("PopFront() error = %v, want %v", err, ErrEmptyDeque)
+	}
+	if _, err := d.PopBack(); err != ErrEmptyDeque {
+		t.Errorf("PopBack() error = %v, want %v", err, ErrEmptyDeque)
+	}
+}
+
+func BenchmarkDeque_PushPop(b *testing.B) {
+	d := NewDeque[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+		d.PushFront(i)
+		_, _ = d.PopBack()
+		_, _ = d.PopFront()
+	}
+}
+
+func ExampleDeque() {
+	// Keep a sliding window of the last three readings
+	window := NewDeque[int]()
+	for _, reading := range []int{4, 8, 15, 16, 23} {
+		window.PushBack(reading)
+		if window.Size() > 3 {
+			_, _ = window.PopFront()
+		}
+	}
+	fmt.Println(window)
+	// Output: [15 16 23]
+}
+
+func ExampleDeque_Rotate() {
+	deque := NewDeque[string]("A", "B", "C", "D")
+	deque.Rotate(1)
+	fmt.Println(deque)
+	deque.Rotate(-2)
+	fmt.Println(deque)
+	// Output:
+	// [B C D A]
+	// [D A B C]
+}
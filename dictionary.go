@@ -0,0 +1,314 @@
+package collections
+
+import (
+	"iter"
+)
+
+// dictionaryStore holds the entries of a Dictionary. There is one
+// implementation for comparable keys, which uses a Go map, and one for keys
+// that need a custom comparer and hash function.
+type dictionaryStore[K any, V any] interface {
+	// get returns the value for the given key and true, or false if the key is
+	// not found.
+	get(key K) (V, bool)
+
+	// put adds the key and value and returns true if the key is new. If the
+	// key is not new, the value is replaced only if overwrite is true.
+	put(key K, value V, overwrite bool) bool
+
+	// remove removes the key and returns true if it was found.
+	remove(key K) bool
+
+	// len returns the number of entries.
+	len() int
+
+	// clear removes all entries.
+	clear()
+
+	// all returns an iterator over the entries.
+	all() iter.Seq2[K, V]
+
+	// empty returns a new, empty store that compares keys in the same way.
+	empty() dictionaryStore[K, V]
+}
+
+// Dictionary implements a collection of keys and values in which each key
+// appears at most once. It is modelled on .NET's Dictionary<TKey, TValue>.
+// Entries are not kept in any particular order. It is not thread-safe.
+//
+// A dictionary created with NewDictionary is backed by a Go map. A dictionary
+// created with NewDictionaryWithEqualityComparer uses the given comparer and
+// hash function instead, so that keys that are not comparable, such as slices,
+// can be used. A Dictionary must be created with one of these functions.
+type Dictionary[K any, V any] struct {
+	store dictionaryStore[K, V]
+}
+
+// NewDictionary returns a new, empty dictionary whose keys are compared with
+// the == operator.
+func NewDictionary[K comparable, V any]() *Dictionary[K, V] {
+	return &Dictionary[K, V]{store: &mapDictionaryStore[K, V]{items: make(map[K]V)}}
+}
+
+// NewDictionaryWithEqualityComparer returns a new, empty dictionary whose keys
+// are compared with the given comparer. The hash function must return the same
+// value for any two keys that the comparer considers equal.
+func NewDictionaryWithEqualityComparer[K any, V any](comparer EqualityComparer[K], hash func(K) uint64) *Dictionary[K, V] {
+	return &Dictionary[K, V]{store: &hashDictionaryStore[K, V]{
+		buckets:  make(map[uint64][]dictionaryEntry[K, V]),
+		comparer: comparer,
+		hash:     hash,
+	}}
+}
+
+// Add adds the given key and value to the dictionary. If the key is already in
+// the dictionary, ErrDuplicateKey is returned and the dictionary is not
+// changed.
+func (d *Dictionary[K, V]) Add(key K, value V) error {
+	if !d.store.put(key, value, false) {
+		return ErrDuplicateKey
+	}
+	return nil
+}
+
+// Set sets the value for the given key, adding the key if it is not already in
+// the dictionary.
+func (d *Dictionary[K, V]) Set(key K, value V) {
+	d.store.put(key, value, true)
+}
+
+// TryGet returns the value for the given key and true. If the key is not
+// found, the zero value and false are returned.
+func (d *Dictionary[K, V]) TryGet(key K) (V, bool) {
+	return d.store.get(key)
+}
+
+// Get returns the value for the given key. If the key is not found,
+// ErrKeyNotFound is returned.
+func (d *Dictionary[K, V]) Get(key K) (V, error) {
+	value, ok := d.store.get(key)
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+// Remove removes the given key and its value from the dictionary. If the key
+// is not found, false is returned, otherwise true is returned.
+func (d *Dictionary[K, V]) Remove(key K) bool {
+	return d.store.remove(key)
+}
+
+// ContainsKey returns true if the dictionary contains the given key.
+func (d *Dictionary[K, V]) ContainsKey(key K) bool {
+	_, ok := d.store.get(key)
+	return ok
+}
+
+// ContainsValue returns true if the dictionary contains the given value,
+// according to the given comparer. It takes O(n) time. For comparable values,
+// pass DefaultEqualityComparer.
+func (d *Dictionary[K, V]) ContainsValue(value V, comparer EqualityComparer[V]) bool {
+	for _, v := range d.store.all() {
+		if comparer(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns an iterator over the keys in the dictionary, in no particular
+// order. The dictionary should not be modified during iteration.
+func (d *Dictionary[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range d.store.all() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in the dictionary, in no
+// particular order. The dictionary should not be modified during iteration.
+func (d *Dictionary[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range d.store.all() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the keys and values in the dictionary, in no
+// particular order. The dictionary should not be modified during iteration.
+func (d *Dictionary[K, V]) All() iter.Seq2[K, V] {
+	return d.store.all()
+}
+
+// Size returns the number of keys in the dictionary.
+func (d *Dictionary[K, V]) Size() int {
+	return d.store.len()
+}
+
+// IsEmpty returns true if the dictionary is empty.
+func (d *Dictionary[K, V]) IsEmpty() bool {
+	return d.store.len() == 0
+}
+
+// Clear removes all keys and values from the dictionary.
+func (d *Dictionary[K, V]) Clear() {
+	d.store.clear()
+}
+
+// empty returns a new, empty dictionary that compares keys in the same way as
+// this one.
+func (d *Dictionary[K, V]) empty() *Dictionary[K, V] {
+	return &Dictionary[K, V]{store: d.store.empty()}
+}
+
+// mapDictionaryStore stores the entries of a dictionary with comparable keys in
+// a Go map.
+type mapDictionaryStore[K comparable, V any] struct {
+	items map[K]V
+}
+
+func (s *mapDictionaryStore[K, V]) get(key K) (V, bool) {
+	value, ok := s.items[key]
+	return value, ok
+}
+
+func (s *mapDictionaryStore[K, V]) put(key K, value V, overwrite bool) bool {
+	_, ok := s.items[key]
+	if !ok || overwrite {
+		s.items[key] = value
+	}
+	return !ok
+}
+
+func (s *mapDictionaryStore[K, V]) remove(key K) bool {
+	_, ok := s.items[key]
+	delete(s.items, key)
+	return ok
+}
+
+func (s *mapDictionaryStore[K, V]) len() int {
+	return len(s.items)
+}
+
+func (s *mapDictionaryStore[K, V]) clear() {
+	clear(s.items)
+}
+
+func (s *mapDictionaryStore[K, V]) all() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range s.items {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *mapDictionaryStore[K, V]) empty() dictionaryStore[K, V] {
+	return &mapDictionaryStore[K, V]{items: make(map[K]V)}
+}
+
+// dictionaryEntry is a key and its value.
+type dictionaryEntry[K any, V any] struct {
+	key   K
+	value V
+}
+
+// hashDictionaryStore stores the entries of a dictionary in buckets, keyed by
+// the hash of their keys. Keys in the same bucket are told apart with the
+// comparer.
+type hashDictionaryStore[K any, V any] struct {
+	buckets  map[uint64][]dictionaryEntry[K, V]
+	comparer EqualityComparer[K]
+	hash     func(K) uint64
+	size     int
+}
+
+// find returns the hash of the key and the index of its entry in the bucket,
+// or -1 if the key is not found.
+func (s *hashDictionaryStore[K, V]) find(key K) (uint64, int) {
+	h := s.hash(key)
+	for i, e := range s.buckets[h] {
+		if s.comparer(e.key, key) {
+			return h, i
+		}
+	}
+	return h, -1
+}
+
+func (s *hashDictionaryStore[K, V]) get(key K) (V, bool) {
+	h, i := s.find(key)
+	if i == -1 {
+		var zero V
+		return zero, false
+	}
+	return s.buckets[h][i].value, true
+}
+
+func (s *hashDictionaryStore[K, V]) put(key K, value V, overwrite bool) bool {
+	h, i := s.find(key)
+	if i != -1 {
+		if overwrite {
+			s.buckets[h][i].value = value
+		}
+		return false
+	}
+	s.buckets[h] = append(s.buckets[h], dictionaryEntry[K, V]{key: key, value: value})
+	s.size++
+	return true
+}
+
+func (s *hashDictionaryStore[K, V]) remove(key K) bool {
+	h, i := s.find(key)
+	if i == -1 {
+		return false
+	}
+
+	bucket := s.buckets[h]
+	if len(bucket) == 1 {
+		delete(s.buckets, h)
+	} else {
+		last := len(bucket) - 1
+		bucket[i] = bucket[last]
+		bucket[last] = dictionaryEntry[K, V]{}
+		s.buckets[h] = bucket[:last]
+	}
+	s.size--
+	return true
+}
+
+func (s *hashDictionaryStore[K, V]) len() int {
+	return s.size
+}
+
+func (s *hashDictionaryStore[K, V]) clear() {
+	clear(s.buckets)
+	s.size = 0
+}
+
+func (s *hashDictionaryStore[K, V]) all() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, bucket := range s.buckets {
+			for _, e := range bucket {
+				if !yield(e.key, e.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *hashDictionaryStore[K, V]) empty() dictionaryStore[K, V] {
+	return &hashDictionaryStore[K, V]{
+		buckets:  make(map[uint64][]dictionaryEntry[K, V]),
+		comparer: s.comparer,
+		hash:     s.hash,
+	}
+}
@@ -0,0 +1,193 @@
+package collections
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"testing"
+)
+
+// hashInts returns a hash of the given slice for dictionaries keyed by slices.
+func hashInts(key []int) uint64 {
+	h := fnv.New64a()
+	for _, v := range key {
+		fmt.Fprint(h, v, ",")
+	}
+	return h.Sum64()
+}
+
+// newTestDictionaries returns an empty dictionary of each kind, keyed by
+// strings.
+func newTestDictionaries() map[string]*Dictionary[string, int] {
+	return map[string]*Dictionary[string, int]{
+		"Map": NewDictionary[string, int](),
+		"Hash": NewDictionaryWithEqualityComparer[string, int](DefaultEqualityComparer[string], func(key string) uint64 {
+			// A poor hash function, so that keys share buckets.
+			return uint64(len(key))
+		}),
+	}
+}
+
+func TestDictionary_Add(t *testing.T) {
+	for name, d := range newTestDictionaries() {
+		t.Run(name, func(t *testing.T) {
+			if err := d.Add("a", 1); err != nil {
+				t.Errorf("Add() error = %v", err)
+			}
+			if err := d.Add("b", 2); err != nil {
+				t.Errorf("Add() error = %v", err)
+			}
+			if err := d.Add("a", 3); err != ErrDuplicateKey {
+				t.Errorf("Add() error = %v, want %v", err, ErrDuplicateKey)
+			}
+			if got, _ := d.Get("a"); got != 1 {
+				t.Errorf("Add() replaced the value with %v", got)
+			}
+			if got := d.Size(); got != 2 {
+				t.Errorf("Size() = %v, want %v", got, 2)
+			}
+		})
+	}
+}
+
+func TestDictionary_Get(t *testing.T) {
+	for name, d := range newTestDictionaries() {
+		t.Run(name, func(t *testing.T) {
+			d.Set("a", 1)
+			d.Set("a", 2)
+
+			if got, err := d.Get("a"); got != 2 || err != nil {
+				t.Errorf("Get() = %v, %v, want %v, %v", got, err, 2, nil)
+			}
+			if _, err := d.Get("b"); err != ErrKeyNotFound {
+				t.Errorf("Get() error = %v, want %v", err, ErrKeyNotFound)
+			}
+			if got, ok := d.TryGet("a"); got != 2 || !ok {
+				t.Errorf("TryGet() = %v, %v, want %v, %v", got, ok, 2, true)
+			}
+			if got, ok := d.TryGet("b"); got != 0 || ok {
+				t.Errorf("TryGet() = %v, %v, want %v, %v", got, ok, 0, false)
+			}
+			if !d.ContainsKey("a") || d.ContainsKey("b") {
+				t.Errorf("ContainsKey() does not match the dictionary")
+			}
+			if !d.ContainsValue(2, DefaultEqualityComparer[int]) || d.ContainsValue(1, DefaultEqualityComparer[int]) {
+				t.Errorf("ContainsValue() does not match the dictionary")
+			}
+		})
+	}
+}
+
+func TestDictionary_ContainsValue_NotComparable(t *testing.T) {
+	d := NewDictionary[string, []byte]()
+	d.Set("a", []byte("x"))
+	if !d.ContainsValue([]byte("x"), bytes.Equal) || d.ContainsValue([]byte("y"), bytes.Equal) {
+		t.Errorf("ContainsValue() does not match the dictionary")
+	}
+}
+
+func TestDictionary_NilKey(t *testing.T) {
+	d := NewDictionary[any, int]()
+	d.Set(nil, 1)
+	for k, v := range d.All() {
+		if k != nil || v != 1 {
+			t.Errorf("All() = %v, %v, want %v, %v", k, v, nil, 1)
+		}
+	}
+}
+
+func TestDictionary_Remove(t *testing.T) {
+	for name, d := range newTestDictionaries() {
+		t.Run(name, func(t *testing.T) {
+			d.Set("a", 1)
+			d.Set("b", 2)
+			d.Set("cc", 3)
+
+			if !d.Remove("a") {
+				t.Errorf("Remove() = false, want true")
+			}
+			if d.Remove("a") {
+				t.Errorf("Remove() = true, want false")
+			}
+			if d.ContainsKey("a") || !d.ContainsKey("b") {
+				t.Errorf("Remove() removed the wrong key")
+			}
+			if got := d.Size(); got != 2 {
+				t.Errorf("Size() = %v, want %v", got, 2)
+			}
+
+			d.Clear()
+			if !d.IsEmpty() || d.ContainsKey("b") {
+				t.Errorf("Clear() left %v keys", d.Size())
+			}
+		})
+	}
+}
+
+func TestDictionary_Iterators(t *testing.T) {
+	for name, d := range newTestDictionaries() {
+		t.Run(name, func(t *testing.T) {
+			want := map[string]int{"a": 1, "b": 2, "cc": 3}
+			for k, v := range want {
+				d.Set(k, v)
+			}
+
+			if got := maps.Collect(d.All()); !maps.Equal(got, want) {
+				t.Errorf("All() = %v, want %v", got, want)
+			}
+			if got := slices.Sorted(d.Keys()); !slices.Equal(got, []string{"a", "b", "cc"}) {
+				t.Errorf("Keys() = %v", got)
+			}
+			if got := slices.Sorted(d.Values()); !slices.Equal(got, []int{1, 2, 3}) {
+				t.Errorf("Values() = %v", got)
+			}
+			for range d.Keys() {
+				break
+			}
+		})
+	}
+}
+
+func TestDictionary_SliceKeys(t *testing.T) {
+	d := NewDictionaryWithEqualityComparer[[]int, string](slices.Equal[[]int], hashInts)
+	_ = d.Add([]int{1, 2}, "a")
+	_ = d.Add([]int{2, 1}, "b")
+
+	if err := d.Add([]int{1, 2}, "c"); err != ErrDuplicateKey {
+		t.Errorf("Add() error = %v, want %v", err, ErrDuplicateKey)
+	}
+	if got, err := d.Get([]int{2, 1}); got != "b" || err != nil {
+		t.Errorf("Get() = %v, %v, want %v, %v", got, err, "b", nil)
+	}
+	if !d.Remove([]int{1, 2}) || d.ContainsKey([]int{1, 2}) {
+		t.Errorf("Remove() did not remove the key")
+	}
+}
+
+func BenchmarkDictionary_Set(b *testing.B) {
+	d := NewDictionary[int, int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.Set(i&1023, i)
+	}
+}
+
+func ExampleDictionary() {
+	d := NewDictionary[string, int]()
+	_ = d.Add("apples", 3)
+	d.Set("pears", 5)
+
+	if err := d.Add("apples", 4); err != nil {
+		fmt.Println(err)
+	}
+	if _, err := d.Get("plums"); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(d.Get("apples"))
+	// Output:
+	// duplicate key
+	// key not found
+	// 3 <nil>
+}
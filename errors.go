@@ -12,3 +12,30 @@ var ErrEmptyStack = errors.New("stack is empty")
 
 // ErrEmptyQueue is returned when the queue is empty.
 var ErrEmptyQueue = errors.New("queue is empty")
+
+// ErrQueueFull is returned when a bounded queue is full.
+var ErrQueueFull = errors.New("queue is full")
+
+// ErrClosed is returned when adding an item to a closed collection, or when
+// removing an item from a closed collection that is empty.
+var ErrClosed = errors.New("collection is closed")
+
+// ErrInvalidHandle is returned when a handle does not refer to an item in the
+// collection, either because it belongs to another collection or because the
+// item has already been removed.
+var ErrInvalidHandle = errors.New("invalid handle")
+
+// ErrEmptyDeque is returned when the deque is empty.
+var ErrEmptyDeque = errors.New("deque is empty")
+
+// ErrStealAborted is returned when a steal from a work-stealing deque loses a
+// race with another thief or with the owner. The deque may still have items,
+// so the steal can be retried.
+var ErrStealAborted = errors.New("steal aborted")
+
+// ErrDuplicateKey is returned when adding a key that is already in the
+// collection.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// ErrKeyNotFound is returned when the key is not in the collection.
+var ErrKeyNotFound = errors.New("key not found")
@@ -0,0 +1,217 @@
+package collections
+
+import (
+	"iter"
+	"sync"
+	"time"
+)
+
+// expiringEntry is a value in an ExpiringDictionary and the time at which it
+// expires.
+type expiringEntry[V any] struct {
+	value    V
+	deadline time.Time
+}
+
+// ExpiringDictionary implements a collection of keys and values in which each
+// entry expires after a time to live. Expired entries are never returned; they
+// are removed when they are next looked up, by Sweep, or by a janitor started
+// with StartJanitor. Time is measured by a Clock, so that tests can control
+// it. It is thread-safe, so that the janitor can run alongside other
+// goroutines.
+type ExpiringDictionary[K any, V any] struct {
+	entries *Dictionary[K, expiringEntry[V]]
+	ttl     time.Duration
+	clock   Clock
+	mutex   sync.Mutex
+}
+
+// NewExpiringDictionary returns a new, empty dictionary whose keys are
+// compared with the == operator, and whose entries expire after the given time
+// to live unless another is given when they are set. If clock is nil,
+// SystemClock is used. It panics if ttl is not positive.
+func NewExpiringDictionary[K comparable, V any](ttl time.Duration, clock Clock) *ExpiringDictionary[K, V] {
+	return newExpiringDictionary(NewDictionary[K, expiringEntry[V]](), ttl, clock)
+}
+
+// NewExpiringDictionaryWithEqualityComparer returns a new, empty dictionary
+// whose keys are compared with the given comparer, and whose entries expire
+// after the given time to live unless another is given when they are set. The
+// hash function must return the same value for any two keys that the comparer
+// considers equal. If clock is nil, SystemClock is used. It panics if ttl is
+// not positive.
+func NewExpiringDictionaryWithEqualityComparer[K any, V any](ttl time.Duration, clock Clock, comparer EqualityComparer[K], hash func(K) uint64) *ExpiringDictionary[K, V] {
+	return newExpiringDictionary(NewDictionaryWithEqualityComparer[K, expiringEntry[V]](comparer, hash), ttl, clock)
+}
+
+func newExpiringDictionary[K any, V any](entries *Dictionary[K, expiringEntry[V]], ttl time.Duration, clock Clock) *ExpiringDictionary[K, V] {
+	if ttl <= 0 {
+		panic("collections: time to live must be positive")
+	}
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	return &ExpiringDictionary[K, V]{entries: entries, ttl: ttl, clock: clock}
+}
+
+// Add adds the given key and value to the dictionary, expiring after the
+// dictionary's time to live. If the key is already in the dictionary and has
+// not expired, ErrDuplicateKey is returned and the dictionary is not changed.
+func (d *ExpiringDictionary[K, V]) Add(key K, value V) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := d.clock.Now()
+	if _, ok := d.get(key, now); ok {
+		return ErrDuplicateKey
+	}
+	d.entries.Set(key, expiringEntry[V]{value: value, deadline: now.Add(d.ttl)})
+	return nil
+}
+
+// Set sets the value for the given key, expiring after the dictionary's time
+// to live.
+func (d *ExpiringDictionary[K, V]) Set(key K, value V) {
+	d.SetWithTTL(key, value, d.ttl)
+}
+
+// SetWithTTL sets the value for the given key, expiring after the given time
+// to live.
+func (d *ExpiringDictionary[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.entries.Set(key, expiringEntry[V]{value: value, deadline: d.clock.Now().Add(ttl)})
+}
+
+// TryGet returns the value for the given key and true. If the key is not
+// found or has expired, the zero value and false are returned.
+func (d *ExpiringDictionary[K, V]) TryGet(key K) (V, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	e, ok := d.get(key, d.clock.Now())
+	return e.value, ok
+}
+
+// Get returns the value for the given key. If the key is not found or has
+// expired, ErrKeyNotFound is returned.
+func (d *ExpiringDictionary[K, V]) Get(key K) (V, error) {
+	value, ok := d.TryGet(key)
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+// ExpiresAt returns the time at which the given key expires and true. If the
+// key is not found or has expired, false is returned.
+func (d *ExpiringDictionary[K, V]) ExpiresAt(key K) (time.Time, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	e, ok := d.get(key, d.clock.Now())
+	return e.deadline, ok
+}
+
+// Remove removes the given key and its value from the dictionary. If the key
+// is not found or has expired, false is returned, otherwise true is returned.
+func (d *ExpiringDictionary[K, V]) Remove(key K) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	_, ok := d.get(key, d.clock.Now())
+	d.entries.Remove(key)
+	return ok
+}
+
+// ContainsKey returns true if the dictionary contains the given key and it
+// has not expired.
+func (d *ExpiringDictionary[K, V]) ContainsKey(key K) bool {
+	_, ok := d.TryGet(key)
+	return ok
+}
+
+// Sweep removes every expired entry from the dictionary and returns the number
+// removed. It takes O(n) time.
+func (d *ExpiringDictionary[K, V]) Sweep() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := d.clock.Now()
+	var expired []K
+	for k, e := range d.entries.All() {
+		if !now.Before(e.deadline) {
+			expired = append(expired, k)
+		}
+	}
+	for _, k := range expired {
+		d.entries.Remove(k)
+	}
+	return len(expired)
+}
+
+// StartJanitor starts a goroutine that calls Sweep every interval, and returns
+// a function that stops it. The function waits for the goroutine to exit, and
+// may be called more than once. It panics if interval is not positive.
+func (d *ExpiringDictionary[K, V]) StartJanitor(interval time.Duration) (stop func()) {
+	return startJanitor(d.clock, interval, func() { d.Sweep() })
+}
+
+// Size returns the number of keys in the dictionary, including expired keys
+// that have not yet been removed. Call Sweep first for an exact count.
+func (d *ExpiringDictionary[K, V]) Size() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.entries.Size()
+}
+
+// Clear removes all keys and values from the dictionary.
+func (d *ExpiringDictionary[K, V]) Clear() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.entries.Clear()
+}
+
+// All returns an iterator over the keys and values in the dictionary that
+// have not expired, in no particular order. The iterator works on a snapshot
+// of the dictionary taken when iteration starts, so the lock is not held while
+// the loop body runs and changes made to the dictionary during iteration are
+// not visible to the iterator.
+func (d *ExpiringDictionary[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, e := range d.snapshot() {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// get returns the entry for the given key and true if it has not expired at
+// the given time. An expired entry is removed. The caller must hold the lock.
+func (d *ExpiringDictionary[K, V]) get(key K, now time.Time) (expiringEntry[V], bool) {
+	e, ok := d.entries.TryGet(key)
+	if !ok {
+		return expiringEntry[V]{}, false
+	}
+	if !now.Before(e.deadline) {
+		d.entries.Remove(key)
+		return expiringEntry[V]{}, false
+	}
+	return e, true
+}
+
+// snapshot returns a copy of the entries that have not expired.
+func (d *ExpiringDictionary[K, V]) snapshot() []dictionaryEntry[K, V] {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := d.clock.Now()
+	entries := make([]dictionaryEntry[K, V], 0, d.entries.Size())
+	for k, e := range d.entries.All() {
+		if now.Before(e.deadline) {
+			entries = append(entries, dictionaryEntry[K, V]{key: k, value: e.value})
+		}
+	}
+	return entries
+}
@@ -0,0 +1,120 @@
+package collections
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestExpiringDictionary(t *testing.T) {
+	c := NewManualClock(epoch)
+	d := NewExpiringDictionary[string, int](time.Minute, c)
+
+	d.Set("a", 1)
+	d.SetWithTTL("b", 2, time.Hour)
+	if err := d.Add("a", 3); err != ErrDuplicateKey {
+		t.Errorf("Add() error = %v, want %v", err, ErrDuplicateKey)
+	}
+	if got, err := d.Get("a"); got != 1 || err != nil {
+		t.Errorf("Get(a) = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if got, ok := d.ExpiresAt("a"); !ok || !got.Equal(epoch.Add(time.Minute)) {
+		t.Errorf("ExpiresAt(a) = %v, %v, want %v, %v", got, ok, epoch.Add(time.Minute), true)
+	}
+
+	c.Advance(time.Minute)
+	if _, err := d.Get("a"); err != ErrKeyNotFound {
+		t.Errorf("Get(a) after expiry error = %v, want %v", err, ErrKeyNotFound)
+	}
+	if d.ContainsKey("a") || !d.ContainsKey("b") {
+		t.Errorf("ContainsKey() does not match the dictionary")
+	}
+	if got := d.Size(); got != 1 {
+		t.Errorf("Size() = %v, want %v", got, 1)
+	}
+
+	// An expired key can be added again.
+	if err := d.Add("a", 4); err != nil {
+		t.Errorf("Add() error = %v, want %v", err, nil)
+	}
+	if got := maps.Collect(d.All()); !maps.Equal(got, map[string]int{"a": 4, "b": 2}) {
+		t.Errorf("All() = %v", got)
+	}
+
+	if !d.Remove("a") || d.Remove("a") {
+		t.Errorf("Remove(a) did not report whether the key was found")
+	}
+	d.Clear()
+	if got := d.Size(); got != 0 {
+		t.Errorf("Size() after Clear() = %v, want %v", got, 0)
+	}
+}
+
+func TestExpiringDictionary_Sweep(t *testing.T) {
+	c := NewManualClock(epoch)
+	d := NewExpiringDictionary[int, int](time.Minute, c)
+	for i := 0; i < 10; i++ {
+		d.SetWithTTL(i, i, time.Duration(i+1)*time.Second)
+	}
+
+	c.Advance(5 * time.Second)
+	if got := slices.Sorted(maps.Keys(maps.Collect(d.All()))); !slices.Equal(got, []int{5, 6, 7, 8, 9}) {
+		t.Errorf("All() keys = %v", got)
+	}
+	if got := d.Size(); got != 10 {
+		t.Errorf("Size() before Sweep() = %v, want %v", got, 10)
+	}
+	if got := d.Sweep(); got != 5 {
+		t.Errorf("Sweep() = %v, want %v", got, 5)
+	}
+	if got := d.Size(); got != 5 {
+		t.Errorf("Size() after Sweep() = %v, want %v", got, 5)
+	}
+	if _, ok := d.ExpiresAt(0); ok {
+		t.Errorf("ExpiresAt(0) = true, want false")
+	}
+}
+
+func TestExpiringDictionary_Janitor(t *testing.T) {
+	c := NewManualClock(epoch)
+	d := NewExpiringDictionaryWithEqualityComparer[[]int, string](time.Second, c, slices.Equal[[]int], hashInts)
+	d.Set([]int{1}, "one")
+	if !d.ContainsKey([]int{1}) {
+		t.Fatalf("ContainsKey([1]) = false, want true")
+	}
+
+	stop := d.StartJanitor(time.Minute)
+	defer stop()
+
+	c.BlockUntil(1)
+	c.Advance(time.Minute)
+	for d.Size() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestNewExpiringDictionary_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewExpiringDictionary(0) did not panic")
+		}
+	}()
+	NewExpiringDictionary[int, int](0, nil)
+}
+
+func ExampleExpiringDictionary() {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	sessions := NewExpiringDictionary[string, string](30*time.Minute, clock)
+	sessions.Set("token", "alice")
+
+	clock.Advance(29 * time.Minute)
+	fmt.Println(sessions.ContainsKey("token"))
+
+	clock.Advance(time.Minute)
+	fmt.Println(sessions.ContainsKey("token"))
+	// Output:
+	// true
+	// false
+}
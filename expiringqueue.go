@@ -0,0 +1,226 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"sync"
+	"time"
+)
+
+// expiringItem is an item in an ExpiringQueue and the time at which it
+// expires.
+type expiringItem[T any] struct {
+	item     T
+	deadline time.Time
+}
+
+// ExpiringQueue implements a FIFO data structure in which each item expires
+// after a time to live. Expired items are dropped when they reach the front of
+// the queue, by Sweep, or by a janitor started with StartJanitor. Time is
+// measured by a Clock, so that tests can control it. It is thread-safe, so
+// that the janitor can run alongside other goroutines.
+//
+// Items with different times to live may expire out of order. Dequeue and
+// Peek only drop expired items from the front, so an expired item behind one
+// that has not expired stays in the queue until it reaches the front or Sweep
+// is called, but it is never returned.
+type ExpiringQueue[T any] struct {
+	items    ring[expiringItem[T]]
+	ttl      time.Duration
+	clock    Clock
+	comparer EqualityComparer[T]
+	mutex    sync.Mutex
+}
+
+// NewExpiringQueue returns a new, empty queue whose items expire after the
+// given time to live unless another is given when they are enqueued. If clock
+// is nil, SystemClock is used. It panics if ttl is not positive.
+func NewExpiringQueue[T comparable](ttl time.Duration, clock Clock) *ExpiringQueue[T] {
+	return NewExpiringQueueWithEqualityComparer(ttl, clock, DefaultEqualityComparer[T])
+}
+
+// NewExpiringQueueWithEqualityComparer returns a new, empty queue whose items
+// expire after the given time to live unless another is given when they are
+// enqueued, and that uses the given comparer to find items. If clock is nil,
+// SystemClock is used. It panics if ttl is not positive.
+func NewExpiringQueueWithEqualityComparer[T any](ttl time.Duration, clock Clock, comparer EqualityComparer[T]) *ExpiringQueue[T] {
+	if ttl <= 0 {
+		panic("collections: time to live must be positive")
+	}
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	return &ExpiringQueue[T]{ttl: ttl, clock: clock, comparer: comparer}
+}
+
+// Enqueue adds an item to the end of the queue, expiring after the queue's
+// time to live.
+func (q *ExpiringQueue[T]) Enqueue(item T) {
+	q.EnqueueWithTTL(item, q.ttl)
+}
+
+// EnqueueWithTTL adds an item to the end of the queue, expiring after the
+// given time to live.
+func (q *ExpiringQueue[T]) EnqueueWithTTL(item T, ttl time.Duration) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.items.pushBack(expiringItem[T]{item: item, deadline: q.clock.Now().Add(ttl)})
+}
+
+// Dequeue removes and returns the first item in the queue that has not
+// expired, dropping the expired items in front of it. If there is no such
+// item, ErrEmptyQueue is returned.
+func (q *ExpiringQueue[T]) Dequeue() (T, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if !q.dropExpired() {
+		var zero T
+		return zero, ErrEmptyQueue
+	}
+	return q.items.popFront().item, nil
+}
+
+// Peek returns the first item in the queue that has not expired without
+// removing it, dropping the expired items in front of it. If there is no such
+// item, ErrEmptyQueue is returned.
+func (q *ExpiringQueue[T]) Peek() (T, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if !q.dropExpired() {
+		var zero T
+		return zero, ErrEmptyQueue
+	}
+	return q.items.front().item, nil
+}
+
+// Sweep removes every expired item from the queue and returns the number
+// removed. It takes O(n) time.
+func (q *ExpiringQueue[T]) Sweep() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	now := q.clock.Now()
+	items := q.items.slice()
+	live := items[:0]
+	for _, it := range items {
+		if now.Before(it.deadline) {
+			live = append(live, it)
+		}
+	}
+	removed := len(items) - len(live)
+	if removed > 0 {
+		q.items = newRing(live)
+	}
+	return removed
+}
+
+// StartJanitor starts a goroutine that calls Sweep every interval, and returns
+// a function that stops it. The function waits for the goroutine to exit, and
+// may be called more than once. It panics if interval is not positive.
+func (q *ExpiringQueue[T]) StartJanitor(interval time.Duration) (stop func()) {
+	return startJanitor(q.clock, interval, func() { q.Sweep() })
+}
+
+// IsEmpty returns true if the queue has no items that have not expired.
+func (q *ExpiringQueue[T]) IsEmpty() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return !q.dropExpired()
+}
+
+// Size returns the number of items in the queue, including expired items that
+// have not yet been removed. Call Sweep first for an exact count.
+func (q *ExpiringQueue[T]) Size() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.items.len()
+}
+
+// Clear removes all items from the queue.
+func (q *ExpiringQueue[T]) Clear() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.items.clear()
+}
+
+// Contains returns true if the queue contains the given item and it has not
+// expired.
+func (q *ExpiringQueue[T]) Contains(item T) bool {
+	for v := range q.Values() {
+		if q.comparer(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// CopyTo copies the items in the queue that have not expired, from front to
+// back, to the given slice, starting at the given index. If the index is out
+// of range, an error is returned. If the slice is not large enough to hold all
+// the items, an error is returned.
+func (q *ExpiringQueue[T]) CopyTo(items []T, index int) error {
+	live := q.snapshot()
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < len(live) {
+		return ErrIndexOutOfRange
+	}
+
+	copy(items[index:], live)
+	return nil
+}
+
+// Values returns an iterator over the items in the queue that have not
+// expired, from front to back, without removing them. The iterator works on a
+// snapshot of the queue taken when iteration starts, so the lock is not held
+// while the loop body runs and changes made to the queue during iteration are
+// not visible to the iterator.
+func (q *ExpiringQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range q.snapshot() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the items in the queue that have
+// not expired, from front to back.
+func (q *ExpiringQueue[T]) String() string {
+	return fmt.Sprintf("%v", q.snapshot())
+}
+
+// dropExpired removes the expired items from the front of the queue, and
+// returns true if an item that has not expired remains. The caller must hold
+// the lock.
+func (q *ExpiringQueue[T]) dropExpired() bool {
+	now := q.clock.Now()
+	for q.items.len() > 0 {
+		if now.Before(q.items.front().deadline) {
+			return true
+		}
+		q.items.popFront()
+	}
+	return false
+}
+
+// snapshot returns a copy of the items that have not expired, from front to
+// back.
+func (q *ExpiringQueue[T]) snapshot() []T {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	now := q.clock.Now()
+	items := make([]T, 0, q.items.len())
+	for i := 0; i < q.items.len(); i++ {
+		if it := q.items.at(i); now.Before(it.deadline) {
+			items = append(items, it.item)
+		}
+	}
+	return items
+}
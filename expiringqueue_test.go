@@ -0,0 +1,157 @@
+package collections
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExpiringQueue(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewExpiringQueue[int](time.Minute, c)
+
+	q.Enqueue(1)
+	c.Advance(30 * time.Second)
+	q.Enqueue(2)
+	q.EnqueueWithTTL(3, time.Hour)
+
+	if got, err := q.Peek(); got != 1 || err != nil {
+		t.Errorf("Peek() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if !q.Contains(1) || q.Contains(4) {
+		t.Errorf("Contains() does not match the queue")
+	}
+
+	c.Advance(30 * time.Second)
+	if q.Contains(1) {
+		t.Errorf("Contains(1) after expiry = true, want false")
+	}
+	if got, err := q.Peek(); got != 2 || err != nil {
+		t.Errorf("Peek() = %v, %v, want %v, %v", got, err, 2, nil)
+	}
+	if got := q.Size(); got != 2 {
+		t.Errorf("Size() = %v, want %v", got, 2)
+	}
+
+	c.Advance(30 * time.Second)
+	if got, err := q.Dequeue(); got != 3 || err != nil {
+		t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, 3, nil)
+	}
+	if _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() = false, want true")
+	}
+}
+
+func TestExpiringQueue_Sweep(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewExpiringQueue[int](time.Minute, c)
+
+	// An item that expires early behind one that does not is never returned,
+	// and Sweep removes it.
+	q.EnqueueWithTTL(1, time.Hour)
+	q.EnqueueWithTTL(2, time.Second)
+	q.EnqueueWithTTL(3, time.Hour)
+	q.EnqueueWithTTL(4, time.Second)
+	c.Advance(time.Second)
+
+	if got := slices.Collect(q.Values()); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("Values() = %v, want %v", got, []int{1, 3})
+	}
+	if got := q.String(); got != "[1 3]" {
+		t.Errorf("String() = %v, want %v", got, "[1 3]")
+	}
+	items := make([]int, 3)
+	if err := q.CopyTo(items, 1); err != nil || !slices.Equal(items, []int{0, 1, 3}) {
+		t.Errorf("CopyTo() = %v, %v", items, err)
+	}
+	if err := q.CopyTo(items, 2); err != ErrIndexOutOfRange {
+		t.Errorf("CopyTo() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	if got := q.Sweep(); got != 2 {
+		t.Errorf("Sweep() = %v, want %v", got, 2)
+	}
+	if got := q.Sweep(); got != 0 {
+		t.Errorf("Sweep() = %v, want %v", got, 0)
+	}
+	if got := q.Size(); got != 2 {
+		t.Errorf("Size() = %v, want %v", got, 2)
+	}
+	if got, _ := q.Dequeue(); got != 1 {
+		t.Errorf("Dequeue() = %v, want %v", got, 1)
+	}
+
+	q.Clear()
+	if got := q.Size(); got != 0 {
+		t.Errorf("Size() after Clear() = %v, want %v", got, 0)
+	}
+}
+
+func TestExpiringQueue_Janitor(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewExpiringQueue[int](time.Second, c)
+	for i := 0; i < 100; i++ {
+		q.Enqueue(i)
+	}
+
+	stop := q.StartJanitor(time.Minute)
+	c.BlockUntil(1)
+	c.Advance(time.Minute)
+	for q.Size() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+}
+
+func TestExpiringQueue_Race(t *testing.T) {
+	c := NewManualClock(epoch)
+	q := NewExpiringQueue[int](time.Second, c)
+	stop := q.StartJanitor(time.Millisecond)
+	defer stop()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				q.Enqueue(i)
+				_, _ = q.Dequeue()
+				if i%100 == 0 {
+					c.Advance(time.Millisecond)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNewExpiringQueue(t *testing.T) {
+	if q := NewExpiringQueue[int](time.Second, nil); q.clock != (SystemClock{}) {
+		t.Errorf("clock = %v, want %v", q.clock, SystemClock{})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewExpiringQueue(0) did not panic")
+		}
+	}()
+	NewExpiringQueue[int](0, nil)
+}
+
+func ExampleExpiringQueue() {
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	q := NewExpiringQueue[string](time.Minute, clock)
+	q.Enqueue("stale")
+	clock.Advance(time.Minute)
+	q.Enqueue("fresh")
+
+	item, _ := q.Dequeue()
+	fmt.Println(item)
+	// Output: fresh
+}
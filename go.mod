@@ -1,3 +1,3 @@
 module github.com/wernerstrydom/go-collections
 
-go 1.18
+go 1.23
@@ -0,0 +1,424 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// HashSet implements a set of items in which each item appears at most once.
+// It is modelled on .NET's HashSet<T>. Items are not kept in any particular
+// order. Adding, removing and looking up an item take constant time on
+// average. It is not thread-safe.
+//
+// A set created with NewHashSet compares items with the == operator. A set
+// created with NewHashSetWithEqualityComparer uses the given comparer and hash
+// function instead, so that items that are not comparable, such as slices, can
+// be used. A HashSet must be created with one of these functions.
+//
+// The set operations, such as UnionWith and IsSubsetOf, take an iterator, so
+// that they can be used with another set, a list or a slice. Items produced by
+// the iterator are compared using this set's comparer, and duplicates are
+// ignored. The iterator is consumed before the set is changed, so it may
+// produce the set's own items, as Values does.
+type HashSet[T any] struct {
+	items Dictionary[T, struct{}]
+}
+
+// NewHashSet returns a new set with the given initial items, compared with the
+// == operator. Duplicate items are added once.
+func NewHashSet[T comparable](values ...T) *HashSet[T] {
+	s := &HashSet[T]{items: *NewDictionary[T, struct{}]()}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// NewHashSetWithEqualityComparer returns a new set with the given initial
+// items, compared with the given comparer. The hash function must return the
+// same value for any two items that the comparer considers equal. Duplicate
+// items are added once.
+func NewHashSetWithEqualityComparer[T any](comparer EqualityComparer[T], hash func(T) uint64, values ...T) *HashSet[T] {
+	s := &HashSet[T]{items: *NewDictionaryWithEqualityComparer[T, struct{}](comparer, hash)}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add adds an item to the set and returns true if it was not already in the
+// set.
+func (s *HashSet[T]) Add(item T) bool {
+	return s.items.Add(item, struct{}{}) == nil
+}
+
+// Remove removes the given item from the set. If the item is not found, false
+// is returned, otherwise true is returned.
+func (s *HashSet[T]) Remove(item T) bool {
+	return s.items.Remove(item)
+}
+
+// Contains returns true if the set contains the given item.
+func (s *HashSet[T]) Contains(item T) bool {
+	return s.items.ContainsKey(item)
+}
+
+// Size returns the number of items in the set.
+func (s *HashSet[T]) Size() int {
+	return s.items.Size()
+}
+
+// IsEmpty returns true if the set is empty.
+func (s *HashSet[T]) IsEmpty() bool {
+	return s.items.IsEmpty()
+}
+
+// Clear removes all items from the set.
+func (s *HashSet[T]) Clear() {
+	s.items.Clear()
+}
+
+// CopyTo copies the items in the set to the given slice, starting at the given
+// index. If the index is out of range, an error is returned. If the slice is
+// not large enough to hold all the items, an error is returned.
+func (s *HashSet[T]) CopyTo(items []T, index int) error {
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < s.Size() {
+		return ErrIndexOutOfRange
+	}
+
+	for v := range s.items.Keys() {
+		items[index] = v
+		index++
+	}
+
+	return nil
+}
+
+// UnionWith adds the items produced by the iterator to the set.
+func (s *HashSet[T]) UnionWith(other iter.Seq[T]) {
+	for _, v := range slices.Collect(other) {
+		s.Add(v)
+	}
+}
+
+// IntersectWith removes the items from the set that are not produced by the
+// iterator. The items that remain are the set's own, not the equal items
+// produced by the iterator.
+func (s *HashSet[T]) IntersectWith(other iter.Seq[T]) {
+	keep := s.distinct(other)
+	var remove []T
+	for v := range s.Values() {
+		if !keep.Contains(v) {
+			remove = append(remove, v)
+		}
+	}
+	for _, v := range remove {
+		s.Remove(v)
+	}
+}
+
+// ExceptWith removes the items produced by the iterator from the set.
+func (s *HashSet[T]) ExceptWith(other iter.Seq[T]) {
+	for _, v := range slices.Collect(other) {
+		s.Remove(v)
+	}
+}
+
+// SymmetricExceptWith changes the set so that it contains only the items that
+// are either in the set or produced by the iterator, but not both.
+func (s *HashSet[T]) SymmetricExceptWith(other iter.Seq[T]) {
+	for v := range s.distinct(other).items.Keys() {
+		if !s.Remove(v) {
+			s.Add(v)
+		}
+	}
+}
+
+// IsSubsetOf returns true if every item in the set is produced by the
+// iterator.
+func (s *HashSet[T]) IsSubsetOf(other iter.Seq[T]) bool {
+	common, _ := s.count(other)
+	return common == s.Size()
+}
+
+// IsProperSubsetOf returns true if every item in the set is produced by the
+// iterator, and the iterator produces at least one item that is not in the
+// set.
+func (s *HashSet[T]) IsProperSubsetOf(other iter.Seq[T]) bool {
+	common, total := s.count(other)
+	return common == s.Size() && total > common
+}
+
+// IsSupersetOf returns true if every item produced by the iterator is in the
+// set.
+func (s *HashSet[T]) IsSupersetOf(other iter.Seq[T]) bool {
+	for v := range other {
+		if !s.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSupersetOf returns true if every item produced by the iterator is in
+// the set, and the set contains at least one item that the iterator does not
+// produce.
+func (s *HashSet[T]) IsProperSupersetOf(other iter.Seq[T]) bool {
+	common, total := s.count(other)
+	return common == total && common < s.Size()
+}
+
+// Overlaps returns true if the iterator produces at least one item that is in
+// the set.
+func (s *HashSet[T]) Overlaps(other iter.Seq[T]) bool {
+	for v := range other {
+		if s.Contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetEquals returns true if the set contains exactly the items produced by the
+// iterator, ignoring order and duplicates.
+func (s *HashSet[T]) SetEquals(other iter.Seq[T]) bool {
+	common, total := s.count(other)
+	return common == total && common == s.Size()
+}
+
+// Values returns an iterator over the items in the set, in no particular
+// order. The set should not be modified during iteration.
+func (s *HashSet[T]) Values() iter.Seq[T] {
+	return s.items.Keys()
+}
+
+// String returns a string representation of the set, in no particular order.
+func (s *HashSet[T]) String() string {
+	items := make([]T, s.Size())
+	_ = s.CopyTo(items, 0)
+	return fmt.Sprintf("%v", items)
+}
+
+// distinct returns a new set, using this set's comparer, of the items produced
+// by the iterator.
+func (s *HashSet[T]) distinct(other iter.Seq[T]) *HashSet[T] {
+	d := &HashSet[T]{items: *s.items.empty()}
+	for v := range other {
+		d.Add(v)
+	}
+	return d
+}
+
+// count returns the number of distinct items produced by the iterator that
+// are in the set, and the number of distinct items produced in total.
+func (s *HashSet[T]) count(other iter.Seq[T]) (common int, total int) {
+	d := s.distinct(other)
+	for v := range d.Values() {
+		if s.Contains(v) {
+			common++
+		}
+	}
+	return common, d.Size()
+}
+
+// ConcurrentHashSet implements a thread-safe HashSet. Its set operations
+// collect the items produced by the iterator before taking the lock, so the
+// iterator may safely read from the set itself.
+//
+// A ConcurrentHashSet must be created with NewConcurrentHashSet or
+// NewConcurrentHashSetWithEqualityComparer.
+type ConcurrentHashSet[T any] struct {
+	set   HashSet[T]
+	mutex sync.RWMutex
+}
+
+// NewConcurrentHashSet returns a new set with the given initial items,
+// compared with the == operator. Duplicate items are added once.
+func NewConcurrentHashSet[T comparable](values ...T) *ConcurrentHashSet[T] {
+	return &ConcurrentHashSet[T]{set: *NewHashSet(values...)}
+}
+
+// NewConcurrentHashSetWithEqualityComparer returns a new set with the given
+// initial items, compared with the given comparer. The hash function must
+// return the same value for any two items that the comparer considers equal.
+// Duplicate items are added once.
+func NewConcurrentHashSetWithEqualityComparer[T any](comparer EqualityComparer[T], hash func(T) uint64, values ...T) *ConcurrentHashSet[T] {
+	return &ConcurrentHashSet[T]{set: *NewHashSetWithEqualityComparer(comparer, hash, values...)}
+}
+
+// Add adds an item to the set and returns true if it was not already in the
+// set.
+func (s *ConcurrentHashSet[T]) Add(item T) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.set.Add(item)
+}
+
+// Remove removes the given item from the set. If the item is not found, false
+// is returned, otherwise true is returned.
+func (s *ConcurrentHashSet[T]) Remove(item T) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.set.Remove(item)
+}
+
+// Contains returns true if the set contains the given item.
+func (s *ConcurrentHashSet[T]) Contains(item T) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.Contains(item)
+}
+
+// Size returns the number of items in the set.
+func (s *ConcurrentHashSet[T]) Size() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.Size()
+}
+
+// IsEmpty returns true if the set is empty.
+func (s *ConcurrentHashSet[T]) IsEmpty() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.IsEmpty()
+}
+
+// Clear removes all items from the set.
+func (s *ConcurrentHashSet[T]) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.set.Clear()
+}
+
+// CopyTo copies the items in the set to the given slice, starting at the given
+// index. If the index is out of range, an error is returned. If the slice is
+// not large enough to hold all the items, an error is returned.
+func (s *ConcurrentHashSet[T]) CopyTo(items []T, index int) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.CopyTo(items, index)
+}
+
+// UnionWith adds the items produced by the iterator to the set.
+func (s *ConcurrentHashSet[T]) UnionWith(other iter.Seq[T]) {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.set.UnionWith(items)
+}
+
+// IntersectWith removes the items from the set that are not produced by the
+// iterator.
+func (s *ConcurrentHashSet[T]) IntersectWith(other iter.Seq[T]) {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.set.IntersectWith(items)
+}
+
+// ExceptWith removes the items produced by the iterator from the set.
+func (s *ConcurrentHashSet[T]) ExceptWith(other iter.Seq[T]) {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.set.ExceptWith(items)
+}
+
+// SymmetricExceptWith changes the set so that it contains only the items that
+// are either in the set or produced by the iterator, but not both.
+func (s *ConcurrentHashSet[T]) SymmetricExceptWith(other iter.Seq[T]) {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.set.SymmetricExceptWith(items)
+}
+
+// IsSubsetOf returns true if every item in the set is produced by the
+// iterator.
+func (s *ConcurrentHashSet[T]) IsSubsetOf(other iter.Seq[T]) bool {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.IsSubsetOf(items)
+}
+
+// IsProperSubsetOf returns true if every item in the set is produced by the
+// iterator, and the iterator produces at least one item that is not in the
+// set.
+func (s *ConcurrentHashSet[T]) IsProperSubsetOf(other iter.Seq[T]) bool {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.IsProperSubsetOf(items)
+}
+
+// IsSupersetOf returns true if every item produced by the iterator is in the
+// set.
+func (s *ConcurrentHashSet[T]) IsSupersetOf(other iter.Seq[T]) bool {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.IsSupersetOf(items)
+}
+
+// IsProperSupersetOf returns true if every item produced by the iterator is in
+// the set, and the set contains at least one item that the iterator does not
+// produce.
+func (s *ConcurrentHashSet[T]) IsProperSupersetOf(other iter.Seq[T]) bool {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.IsProperSupersetOf(items)
+}
+
+// Overlaps returns true if the iterator produces at least one item that is in
+// the set.
+func (s *ConcurrentHashSet[T]) Overlaps(other iter.Seq[T]) bool {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.Overlaps(items)
+}
+
+// SetEquals returns true if the set contains exactly the items produced by the
+// iterator, ignoring order and duplicates.
+func (s *ConcurrentHashSet[T]) SetEquals(other iter.Seq[T]) bool {
+	items := slices.Values(slices.Collect(other))
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.SetEquals(items)
+}
+
+// Values returns an iterator over the items in the set, in no particular
+// order. The iterator works on a snapshot of the set taken when iteration
+// starts, so the lock is not held while the loop body runs and changes made to
+// the set during iteration are not visible to the iterator.
+func (s *ConcurrentHashSet[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.snapshot() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the set, in no particular order.
+func (s *ConcurrentHashSet[T]) String() string {
+	return fmt.Sprintf("%v", s.snapshot())
+}
+
+// snapshot returns a copy of the items in the set.
+func (s *ConcurrentHashSet[T]) snapshot() []T {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	items := make([]T, s.set.Size())
+	_ = s.set.CopyTo(items, 0)
+	return items
+}
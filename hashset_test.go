@@ -0,0 +1,230 @@
+package collections
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// hashFold returns a hash of the given string that ignores case, for sets
+// compared with strings.EqualFold.
+func hashFold(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(strings.ToLower(s)))
+	return h.Sum64()
+}
+
+// sortedValues returns the items produced by the iterator, in ascending order.
+func sortedValues(items func(func(int) bool)) []int {
+	s := slices.Collect(items)
+	slices.Sort(s)
+	return s
+}
+
+func TestHashSet_Add(t *testing.T) {
+	s := NewHashSet[int](1, 2, 2)
+	if got := s.Size(); got != 2 {
+		t.Errorf("Size() = %v, want %v", got, 2)
+	}
+	if !s.Add(3) {
+		t.Errorf("Add(3) = false, want true")
+	}
+	if s.Add(3) {
+		t.Errorf("Add(3) = true, want false")
+	}
+	if !s.Remove(1) || s.Remove(1) {
+		t.Errorf("Remove(1) did not report whether the item was found")
+	}
+	if s.Contains(1) || !s.Contains(2) {
+		t.Errorf("Contains() does not match the set")
+	}
+	if got := sortedValues(s.Values()); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Values() = %v, want %v", got, []int{2, 3})
+	}
+}
+
+func TestHashSet_Modify(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *HashSet[int], other []int)
+		other  []int
+		want   []int
+	}{
+		{name: "UnionWith", modify: func(s *HashSet[int], o []int) { s.UnionWith(slices.Values(o)) }, other: []int{3, 4, 5, 5}, want: []int{1, 2, 3, 4, 5}},
+		{name: "IntersectWith", modify: func(s *HashSet[int], o []int) { s.IntersectWith(slices.Values(o)) }, other: []int{3, 4, 5, 5}, want: []int{3, 4}},
+		{name: "IntersectWith/Empty", modify: func(s *HashSet[int], o []int) { s.IntersectWith(slices.Values(o)) }, other: nil, want: nil},
+		{name: "ExceptWith", modify: func(s *HashSet[int], o []int) { s.ExceptWith(slices.Values(o)) }, other: []int{3, 4, 5, 5}, want: []int{1, 2}},
+		{name: "SymmetricExceptWith", modify: func(s *HashSet[int], o []int) { s.SymmetricExceptWith(slices.Values(o)) }, other: []int{3, 4, 5, 5}, want: []int{1, 2, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewHashSet[int](1, 2, 3, 4)
+			tt.modify(s, tt.other)
+			if got := sortedValues(s.Values()); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if got := s.Size(); got != len(tt.want) {
+				t.Errorf("Size() = %v, want %v", got, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestHashSet_Compare(t *testing.T) {
+	tests := []struct {
+		other                                          []int
+		subset, properSubset, superset, properSuperset bool
+		overlaps, equals                               bool
+	}{
+		{other: []int{1, 2, 3, 3}, subset: true, superset: true, overlaps: true, equals: true},
+		{other: []int{1, 2, 3, 4}, subset: true, properSubset: true, overlaps: true},
+		{other: []int{1, 2, 2}, superset: true, properSuperset: true, overlaps: true},
+		{other: []int{3, 4}, overlaps: true},
+		{other: []int{4, 5}},
+		{other: nil, superset: true, properSuperset: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.other), func(t *testing.T) {
+			s := NewHashSet[int](1, 2, 3)
+			other := slices.Values(tt.other)
+			if got := s.IsSubsetOf(other); got != tt.subset {
+				t.Errorf("IsSubsetOf() = %v, want %v", got, tt.subset)
+			}
+			if got := s.IsProperSubsetOf(other); got != tt.properSubset {
+				t.Errorf("IsProperSubsetOf() = %v, want %v", got, tt.properSubset)
+			}
+			if got := s.IsSupersetOf(other); got != tt.superset {
+				t.Errorf("IsSupersetOf() = %v, want %v", got, tt.superset)
+			}
+			if got := s.IsProperSupersetOf(other); got != tt.properSuperset {
+				t.Errorf("IsProperSupersetOf() = %v, want %v", got, tt.properSuperset)
+			}
+			if got := s.Overlaps(other); got != tt.overlaps {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.overlaps)
+			}
+			if got := s.SetEquals(other); got != tt.equals {
+				t.Errorf("SetEquals() = %v, want %v", got, tt.equals)
+			}
+		})
+	}
+}
+
+func TestHashSet_EqualityComparer(t *testing.T) {
+	s := NewHashSetWithEqualityComparer(slices.Equal[[]int], hashInts, []int{1}, []int{2})
+	if s.Add([]int{1}) {
+		t.Errorf("Add([1]) = true, want false")
+	}
+
+	// The other items are compared with the set's comparer, so the
+	// duplicates are ignored.
+	other := slices.Values([][]int{{2}, {3}, {3}})
+	s.SymmetricExceptWith(other)
+	if !s.SetEquals(slices.Values([][]int{{1}, {3}})) {
+		t.Errorf("SymmetricExceptWith() = %v, want %v", s, [][]int{{1}, {3}})
+	}
+	if !s.IsProperSubsetOf(slices.Values([][]int{{1}, {3}, {4}, {4}})) {
+		t.Errorf("IsProperSubsetOf() = false, want true")
+	}
+}
+
+func TestHashSet_ModifyWithOwnValues(t *testing.T) {
+	// Every item hashes to the same bucket, so removing one moves the others
+	// within the bucket that Values is reading.
+	sameHash := func([]int) uint64 { return 0 }
+	items := [][]int{{1}, {2}, {3}, {4}}
+
+	s := NewHashSetWithEqualityComparer(slices.Equal[[]int], sameHash, items...)
+	s.ExceptWith(s.Values())
+	if !s.IsEmpty() {
+		t.Errorf("ExceptWith(Values()) left %v", s)
+	}
+
+	s = NewHashSetWithEqualityComparer(slices.Equal[[]int], sameHash, items...)
+	s.UnionWith(s.Values())
+	s.IntersectWith(s.Values())
+	if !s.SetEquals(slices.Values(items)) {
+		t.Errorf("UnionWith(Values()) and IntersectWith(Values()) = %v, want %v", s, items)
+	}
+
+	s.SymmetricExceptWith(s.Values())
+	if !s.IsEmpty() {
+		t.Errorf("SymmetricExceptWith(Values()) left %v", s)
+	}
+}
+
+func TestHashSet_IntersectWithKeepsOwnItems(t *testing.T) {
+	s := NewHashSetWithEqualityComparer(strings.EqualFold, hashFold, "A", "B")
+	s.IntersectWith(slices.Values([]string{"a", "c"}))
+	if got := slices.Collect(s.Values()); !slices.Equal(got, []string{"A"}) {
+		t.Errorf("IntersectWith() = %v, want %v", got, []string{"A"})
+	}
+}
+
+func TestConcurrentHashSet(t *testing.T) {
+	s := NewConcurrentHashSet[int](1, 2, 3)
+	if s.Add(1) || !s.Add(4) {
+		t.Errorf("Add() did not report whether the item was new")
+	}
+
+	// The set operations may read from the set itself.
+	s.UnionWith(s.Values())
+	if !s.SetEquals(s.Values()) || !s.IsSubsetOf(s.Values()) || s.IsProperSubsetOf(s.Values()) {
+		t.Errorf("a set does not equal itself")
+	}
+	s.IntersectWith(slices.Values([]int{2, 3, 5}))
+	s.SymmetricExceptWith(slices.Values([]int{3, 6}))
+	s.ExceptWith(slices.Values([]int{6}))
+	if got := sortedValues(s.Values()); !slices.Equal(got, []int{2}) {
+		t.Errorf("Values() = %v, want %v", got, []int{2})
+	}
+	if !s.IsSupersetOf(slices.Values([]int{2})) || s.IsProperSupersetOf(slices.Values([]int{2})) || !s.Overlaps(slices.Values([]int{1, 2})) {
+		t.Errorf("set comparisons do not match the set")
+	}
+	if got := s.String(); got != "[2]" {
+		t.Errorf("String() = %v, want %v", got, "[2]")
+	}
+}
+
+func TestConcurrentHashSet_Race(t *testing.T) {
+	s := NewConcurrentHashSet[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := g * 1000; i < (g+1)*1000; i++ {
+				s.Add(i)
+				s.Contains(i)
+				if i%10 == 0 {
+					s.Remove(i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for i := 0; i < 8000; i++ {
+		if s.Contains(i) != (i%10 != 0) {
+			t.Fatalf("Contains(%v) = %v, want %v", i, s.Contains(i), i%10 != 0)
+		}
+	}
+}
+
+func BenchmarkHashSet_Add(b *testing.B) {
+	s := NewHashSet[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Add(i)
+	}
+}
+
+func ExampleHashSet_SymmetricExceptWith() {
+	s := NewHashSet[string]("apple", "banana", "cherry")
+	s.SymmetricExceptWith(slices.Values([]string{"banana", "date"}))
+
+	fmt.Println(s.Contains("banana"), s.Contains("date"), s.Size())
+	// Output: false true 3
+}
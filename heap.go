@@ -0,0 +1,57 @@
+package collections
+
+// heapify arranges the items into a binary min-heap ordered by the given
+// comparer.
+func heapify[T any](items []T, comparer Comparer[T]) {
+	for i := len(items)/2 - 1; i >= 0; i-- {
+		heapDown(items, i, comparer)
+	}
+}
+
+// heapUp moves the item at the given index up the heap until its parent is
+// not greater than it.
+func heapUp[T any](items []T, i int, comparer Comparer[T]) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if comparer(items[i], items[parent]) >= 0 {
+			break
+		}
+		items[i], items[parent] = items[parent], items[i]
+		i = parent
+	}
+}
+
+// heapDown moves the item at the given index down the heap until neither of
+// its children is less than it.
+func heapDown[T any](items []T, i int, comparer Comparer[T]) {
+	n := len(items)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && comparer(items[left], items[smallest]) < 0 {
+			smallest = left
+		}
+		if right < n && comparer(items[right], items[smallest]) < 0 {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		items[i], items[smallest] = items[smallest], items[i]
+		i = smallest
+	}
+}
+
+// heapPop removes and returns the smallest item from the heap. The heap must
+// not be empty. The vacated slot is zeroed so that it can be garbage
+// collected.
+func heapPop[T any](items []T, comparer Comparer[T]) ([]T, T) {
+	var zero T
+	n := len(items) - 1
+	item := items[0]
+	items[0] = items[n]
+	items[n] = zero
+	items = items[:n]
+	heapDown(items, 0, comparer)
+	return items, item
+}
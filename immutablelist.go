@@ -0,0 +1,583 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+// immutableListOwner identifies the operation or builder that may modify a
+// node in place. It has a field so that every owner has a distinct address.
+type immutableListOwner struct {
+	_ byte
+}
+
+// immutableListNode is a node in the AVL tree that backs an ImmutableList. The
+// tree is ordered by position, and each node records the size of its subtree
+// so that items can be found by index.
+//
+// A node may only be modified by its owner. Nodes are created by a single
+// operation or builder, and once that has finished nobody holds the owner
+// any more, so the node is effectively frozen and can be shared by any number
+// of lists.
+type immutableListNode[T any] struct {
+	value  T
+	left   *immutableListNode[T]
+	right  *immutableListNode[T]
+	height int
+	size   int
+	owner  *immutableListOwner
+}
+
+// ImmutableList implements a list that cannot be modified. Set, Insert,
+// RemoveAt and the other methods that change the list return a new list and
+// leave the original unchanged. The list is stored in a balanced binary tree,
+// so these methods and Get take O(log n) time, and the new list shares all but
+// O(log n) nodes with the original. Because it never changes, an ImmutableList
+// can be used from any number of goroutines.
+//
+// To make many changes at once, use ToBuilder to get an ImmutableListBuilder,
+// which modifies its own nodes in place, and then ToImmutable to get the new
+// list.
+type ImmutableList[T any] struct {
+	root     *immutableListNode[T]
+	comparer EqualityComparer[T]
+}
+
+// NewImmutableList returns a new list with the given initial items.
+func NewImmutableList[T comparable](values ...T) *ImmutableList[T] {
+	return NewImmutableListWithEqualityComparer(DefaultEqualityComparer[T], values...)
+}
+
+// NewImmutableListWithEqualityComparer returns a new list with the given
+// initial items that uses the given comparer to find items.
+func NewImmutableListWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *ImmutableList[T] {
+	return &ImmutableList[T]{root: buildImmutableListNode(values, new(immutableListOwner)), comparer: comparer}
+}
+
+// Add returns a new list with the given item added to the end.
+func (l *ImmutableList[T]) Add(item T) *ImmutableList[T] {
+	return l.with(l.root.insert(l.root.count(), item, new(immutableListOwner)))
+}
+
+// AddRange returns a new list with the given items added to the end.
+func (l *ImmutableList[T]) AddRange(items ...T) *ImmutableList[T] {
+	b := l.ToBuilder()
+	b.AddRange(items...)
+	return b.ToImmutable()
+}
+
+// Insert returns a new list with the given item inserted at the given index.
+// If the index is out of range, an error is returned along with the list
+// itself.
+func (l *ImmutableList[T]) Insert(index int, item T) (*ImmutableList[T], error) {
+	if index < 0 || index > l.root.count() {
+		return l, ErrIndexOutOfRange
+	}
+	return l.with(l.root.insert(index, item, new(immutableListOwner))), nil
+}
+
+// Set returns a new list with the item at the given index replaced. If the
+// index is out of range, an error is returned along with the list itself.
+func (l *ImmutableList[T]) Set(index int, item T) (*ImmutableList[T], error) {
+	if index < 0 || index >= l.root.count() {
+		return l, ErrIndexOutOfRange
+	}
+	return l.with(l.root.set(index, item, new(immutableListOwner))), nil
+}
+
+// Remove returns a new list without the first occurrence of the given item,
+// and true. If the item is not found, the list itself and false are returned.
+func (l *ImmutableList[T]) Remove(item T) (*ImmutableList[T], bool) {
+	index := l.IndexOf(item)
+	if index == -1 {
+		return l, false
+	}
+	return l.with(l.root.removeAt(index, new(immutableListOwner))), true
+}
+
+// RemoveAt returns a new list without the item at the given index. If the
+// index is out of range, an error is returned along with the list itself.
+func (l *ImmutableList[T]) RemoveAt(index int) (*ImmutableList[T], error) {
+	if index < 0 || index >= l.root.count() {
+		return l, ErrIndexOutOfRange
+	}
+	return l.with(l.root.removeAt(index, new(immutableListOwner))), nil
+}
+
+// Clear returns an empty list that uses the same comparer.
+func (l *ImmutableList[T]) Clear() *ImmutableList[T] {
+	return l.with(nil)
+}
+
+// Get returns the item at the given index.
+func (l *ImmutableList[T]) Get(index int) (T, error) {
+	return l.root.get(index)
+}
+
+// IndexOf returns the index of the given item. If the item is not found, -1 is
+// returned.
+func (l *ImmutableList[T]) IndexOf(item T) int {
+	return l.root.indexOf(item, l.comparer)
+}
+
+// LastIndexOf returns the last index of the given item. If the item is not
+// found, -1 is returned.
+func (l *ImmutableList[T]) LastIndexOf(item T) int {
+	return l.root.lastIndexOf(item, l.comparer)
+}
+
+// Contains returns true if the list contains the given item.
+func (l *ImmutableList[T]) Contains(item T) bool {
+	return l.IndexOf(item) != -1
+}
+
+// CopyTo copies the items in the list to the given slice, starting at the given
+// index. If the index is out of range, an error is returned. If the slice is
+// not large enough to hold all the items, an error is returned.
+func (l *ImmutableList[T]) CopyTo(items []T, index int) error {
+	return l.root.copyTo(items, index)
+}
+
+// Size returns the number of items in the list.
+func (l *ImmutableList[T]) Size() int {
+	return l.root.count()
+}
+
+// IsEmpty returns true if the list is empty.
+func (l *ImmutableList[T]) IsEmpty() bool {
+	return l.root == nil
+}
+
+// All returns an iterator over the indexes and items in the list, from first
+// to last.
+func (l *ImmutableList[T]) All() iter.Seq2[int, T] {
+	return l.root.all()
+}
+
+// Values returns an iterator over the items in the list, from first to last.
+func (l *ImmutableList[T]) Values() iter.Seq[T] {
+	return l.root.values()
+}
+
+// Backward returns an iterator over the indexes and items in the list, from
+// last to first.
+func (l *ImmutableList[T]) Backward() iter.Seq2[int, T] {
+	return l.root.backward()
+}
+
+// String returns a string representation of the list.
+func (l *ImmutableList[T]) String() string {
+	return l.root.String()
+}
+
+// ToBuilder returns a builder that starts with the items in the list. The
+// builder shares its nodes with the list until it changes them, so creating it
+// takes constant time.
+func (l *ImmutableList[T]) ToBuilder() *ImmutableListBuilder[T] {
+	return &ImmutableListBuilder[T]{root: l.root, comparer: l.comparer, owner: new(immutableListOwner)}
+}
+
+// with returns a list with the given root that uses the same comparer.
+func (l *ImmutableList[T]) with(root *immutableListNode[T]) *ImmutableList[T] {
+	return &ImmutableList[T]{root: root, comparer: l.comparer}
+}
+
+// ImmutableListBuilder builds an ImmutableList through a series of changes
+// made in place. It has the same methods as List, but unlike List, creating a
+// builder from an ImmutableList and getting an ImmutableList back from it do
+// not copy the items. It is not thread-safe.
+type ImmutableListBuilder[T any] struct {
+	root     *immutableListNode[T]
+	comparer EqualityComparer[T]
+	owner    *immutableListOwner
+}
+
+// Add adds an item to the end of the list.
+func (b *ImmutableListBuilder[T]) Add(item T) {
+	b.root = b.root.insert(b.root.count(), item, b.owner)
+}
+
+// AddRange adds the given items to the end of the list.
+func (b *ImmutableListBuilder[T]) AddRange(items ...T) {
+	for _, item := range items {
+		b.Add(item)
+	}
+}
+
+// Insert inserts an item at the given index.
+func (b *ImmutableListBuilder[T]) Insert(index int, item T) error {
+	if index < 0 || index > b.root.count() {
+		return ErrIndexOutOfRange
+	}
+	b.root = b.root.insert(index, item, b.owner)
+	return nil
+}
+
+// Set sets the item at the given index.
+func (b *ImmutableListBuilder[T]) Set(index int, item T) error {
+	if index < 0 || index >= b.root.count() {
+		return ErrIndexOutOfRange
+	}
+	b.root = b.root.set(index, item, b.owner)
+	return nil
+}
+
+// Remove removes the given item from the list. If the item is not found, false
+// is returned, otherwise true is returned.
+func (b *ImmutableListBuilder[T]) Remove(item T) bool {
+	index := b.IndexOf(item)
+	if index == -1 {
+		return false
+	}
+	b.root = b.root.removeAt(index, b.owner)
+	return true
+}
+
+// RemoveAt removes the item at the given index.
+func (b *ImmutableListBuilder[T]) RemoveAt(index int) error {
+	if index < 0 || index >= b.root.count() {
+		return ErrIndexOutOfRange
+	}
+	b.root = b.root.removeAt(index, b.owner)
+	return nil
+}
+
+// Clear removes all items from the list.
+func (b *ImmutableListBuilder[T]) Clear() {
+	b.root = nil
+}
+
+// Get returns the item at the given index.
+func (b *ImmutableListBuilder[T]) Get(index int) (T, error) {
+	return b.root.get(index)
+}
+
+// IndexOf returns the index of the given item. If the item is not found, -1 is
+// returned.
+func (b *ImmutableListBuilder[T]) IndexOf(item T) int {
+	return b.root.indexOf(item, b.comparer)
+}
+
+// LastIndexOf returns the last index of the given item. If the item is not
+// found, -1 is returned.
+func (b *ImmutableListBuilder[T]) LastIndexOf(item T) int {
+	return b.root.lastIndexOf(item, b.comparer)
+}
+
+// Contains returns true if the list contains the given item.
+func (b *ImmutableListBuilder[T]) Contains(item T) bool {
+	return b.IndexOf(item) != -1
+}
+
+// CopyTo copies the items in the list to the given slice, starting at the given
+// index. If the index is out of range, an error is returned. If the slice is
+// not large enough to hold all the items, an error is returned.
+func (b *ImmutableListBuilder[T]) CopyTo(items []T, index int) error {
+	return b.root.copyTo(items, index)
+}
+
+// Size returns the number of items in the list.
+func (b *ImmutableListBuilder[T]) Size() int {
+	return b.root.count()
+}
+
+// IsEmpty returns true if the list is empty.
+func (b *ImmutableListBuilder[T]) IsEmpty() bool {
+	return b.root == nil
+}
+
+// All returns an iterator over the indexes and items in the list, from first
+// to last. The list should not be modified during iteration.
+func (b *ImmutableListBuilder[T]) All() iter.Seq2[int, T] {
+	return b.root.all()
+}
+
+// Values returns an iterator over the items in the list, from first to last.
+// The list should not be modified during iteration.
+func (b *ImmutableListBuilder[T]) Values() iter.Seq[T] {
+	return b.root.values()
+}
+
+// String returns a string representation of the list.
+func (b *ImmutableListBuilder[T]) String() string {
+	return b.root.String()
+}
+
+// ToImmutable returns an ImmutableList with the items in the builder. The
+// builder can still be used afterwards; its next change copies the nodes it
+// needs instead of modifying the ones now shared with the list.
+func (b *ImmutableListBuilder[T]) ToImmutable() *ImmutableList[T] {
+	b.owner = new(immutableListOwner)
+	return &ImmutableList[T]{root: b.root, comparer: b.comparer}
+}
+
+// buildImmutableListNode returns a balanced tree holding the given items.
+func buildImmutableListNode[T any](items []T, owner *immutableListOwner) *immutableListNode[T] {
+	if len(items) == 0 {
+		return nil
+	}
+	mid := len(items) / 2
+	n := &immutableListNode[T]{
+		value: items[mid],
+		left:  buildImmutableListNode(items[:mid], owner),
+		right: buildImmutableListNode(items[mid+1:], owner),
+		owner: owner,
+	}
+	n.update()
+	return n
+}
+
+// count returns the number of nodes in the tree rooted at n.
+func (n *immutableListNode[T]) count() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// depth returns the height of the tree rooted at n.
+func (n *immutableListNode[T]) depth() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and size of n from its children.
+func (n *immutableListNode[T]) update() {
+	n.height = 1 + max(n.left.depth(), n.right.depth())
+	n.size = 1 + n.left.count() + n.right.count()
+}
+
+// edit returns n if it belongs to the given owner, or a copy of n that does
+// otherwise.
+func (n *immutableListNode[T]) edit(owner *immutableListOwner) *immutableListNode[T] {
+	if n.owner == owner {
+		return n
+	}
+	c := *n
+	c.owner = owner
+	return &c
+}
+
+// get returns the item at the given index.
+func (n *immutableListNode[T]) get(index int) (T, error) {
+	if index < 0 || index >= n.count() {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+
+	for {
+		left := n.left.count()
+		switch {
+		case index < left:
+			n = n.left
+		case index > left:
+			index -= left + 1
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+}
+
+// set returns the tree with the item at the given index replaced. The index
+// must be in range.
+func (n *immutableListNode[T]) set(index int, item T, owner *immutableListOwner) *immutableListNode[T] {
+	n = n.edit(owner)
+	left := n.left.count()
+	switch {
+	case index < left:
+		n.left = n.left.set(index, item, owner)
+	case index > left:
+		n.right = n.right.set(index-left-1, item, owner)
+	default:
+		n.value = item
+	}
+	return n
+}
+
+// insert returns the tree with the item inserted at the given index. The index
+// must be in range.
+func (n *immutableListNode[T]) insert(index int, item T, owner *immutableListOwner) *immutableListNode[T] {
+	if n == nil {
+		return &immutableListNode[T]{value: item, height: 1, size: 1, owner: owner}
+	}
+
+	n = n.edit(owner)
+	if left := n.left.count(); index <= left {
+		n.left = n.left.insert(index, item, owner)
+	} else {
+		n.right = n.right.insert(index-left-1, item, owner)
+	}
+	return n.balance(owner)
+}
+
+// removeAt returns the tree without the item at the given index. The index must
+// be in range.
+func (n *immutableListNode[T]) removeAt(index int, owner *immutableListOwner) *immutableListNode[T] {
+	left := n.left.count()
+	if index == left {
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+	}
+
+	n = n.edit(owner)
+	switch {
+	case index < left:
+		n.left = n.left.removeAt(index, owner)
+	case index > left:
+		n.right = n.right.removeAt(index-left-1, owner)
+	default:
+		// Replace the item with its successor, the first item on the right.
+		n.value, _ = n.right.get(0)
+		n.right = n.right.removeAt(0, owner)
+	}
+	return n.balance(owner)
+}
+
+// balance updates n, which must belong to the given owner, and rotates it if
+// its subtrees differ in height by more than one.
+func (n *immutableListNode[T]) balance(owner *immutableListOwner) *immutableListNode[T] {
+	n.update()
+	switch diff := n.left.depth() - n.right.depth(); {
+	case diff > 1:
+		if n.left.left.depth() < n.left.right.depth() {
+			n.left = n.left.rotateLeft(owner)
+		}
+		return n.rotateRight(owner)
+	case diff < -1:
+		if n.right.right.depth() < n.right.left.depth() {
+			n.right = n.right.rotateRight(owner)
+		}
+		return n.rotateLeft(owner)
+	default:
+		return n
+	}
+}
+
+// rotateLeft makes the right child of n the root of the subtree.
+func (n *immutableListNode[T]) rotateLeft(owner *immutableListOwner) *immutableListNode[T] {
+	n = n.edit(owner)
+	r := n.right.edit(owner)
+	n.right = r.left
+	n.update()
+	r.left = n
+	r.update()
+	return r
+}
+
+// rotateRight makes the left child of n the root of the subtree.
+func (n *immutableListNode[T]) rotateRight(owner *immutableListOwner) *immutableListNode[T] {
+	n = n.edit(owner)
+	l := n.left.edit(owner)
+	n.left = l.right
+	n.update()
+	l.right = n
+	l.update()
+	return l
+}
+
+// indexOf returns the index of the first item equal to the given item, or -1.
+func (n *immutableListNode[T]) indexOf(item T, comparer EqualityComparer[T]) int {
+	for i, v := range n.all() {
+		if comparer(v, item) {
+			return i
+		}
+	}
+	return -1
+}
+
+// lastIndexOf returns the index of the last item equal to the given item, or
+// -1.
+func (n *immutableListNode[T]) lastIndexOf(item T, comparer EqualityComparer[T]) int {
+	for i, v := range n.backward() {
+		if comparer(v, item) {
+			return i
+		}
+	}
+	return -1
+}
+
+// copyTo copies the items in the tree to the given slice, starting at the given
+// index.
+func (n *immutableListNode[T]) copyTo(items []T, index int) error {
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < n.count() {
+		return ErrIndexOutOfRange
+	}
+
+	for i, v := range n.all() {
+		items[index+i] = v
+	}
+
+	return nil
+}
+
+// all returns an iterator over the indexes and items in the tree, in order.
+func (n *immutableListNode[T]) all() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		n.walk(func(v T) bool {
+			if !yield(i, v) {
+				return false
+			}
+			i++
+			return true
+		})
+	}
+}
+
+// values returns an iterator over the items in the tree, in order.
+func (n *immutableListNode[T]) values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		n.walk(yield)
+	}
+}
+
+// backward returns an iterator over the indexes and items in the tree, in
+// reverse order.
+func (n *immutableListNode[T]) backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := n.count() - 1
+		n.walkBackward(func(v T) bool {
+			if !yield(i, v) {
+				return false
+			}
+			i--
+			return true
+		})
+	}
+}
+
+// walk calls yield for each item in the tree, in order, until yield returns
+// false. It returns false if iteration was stopped.
+func (n *immutableListNode[T]) walk(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.walk(yield) && yield(n.value) && n.right.walk(yield)
+}
+
+// walkBackward calls yield for each item in the tree, in reverse order, until
+// yield returns false. It returns false if iteration was stopped.
+func (n *immutableListNode[T]) walkBackward(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.right.walkBackward(yield) && yield(n.value) && n.left.walkBackward(yield)
+}
+
+// String returns a string representation of the items in the tree.
+func (n *immutableListNode[T]) String() string {
+	items := make([]T, n.count())
+	_ = n.copyTo(items, 0)
+	return fmt.Sprintf("%v", items)
+}
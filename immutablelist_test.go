@@ -0,0 +1,240 @@
+package collections
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkImmutableList fails the test if the list does not hold the expected
+// items or if its tree is not a valid AVL tree.
+func checkImmutableList[T any](t *testing.T, root *immutableListNode[T], want []T) {
+	t.Helper()
+
+	var check func(n *immutableListNode[T]) (height, size int)
+	check = func(n *immutableListNode[T]) (int, int) {
+		if n == nil {
+			return 0, 0
+		}
+		lh, ls := check(n.left)
+		rh, rs := check(n.right)
+		if lh-rh > 1 || rh-lh > 1 {
+			t.Fatalf("node %v is unbalanced: left height %v, right height %v", n.value, lh, rh)
+		}
+		if n.height != 1+max(lh, rh) || n.size != 1+ls+rs {
+			t.Fatalf("node %v has height %v and size %v, want %v and %v", n.value, n.height, n.size, 1+max(lh, rh), 1+ls+rs)
+		}
+		return n.height, n.size
+	}
+	check(root)
+
+	got := slices.Collect(root.values())
+	if len(got) != len(want) || (len(want) > 0 && !reflect.DeepEqual(got, want)) {
+		t.Fatalf("items = %v, want %v", got, want)
+	}
+}
+
+func TestNewImmutableList(t *testing.T) {
+	for n := 0; n < 20; n++ {
+		items := make([]int, n)
+		for i := range items {
+			items[i] = i
+		}
+		l := NewImmutableList(items...)
+		checkImmutableList(t, l.root, items)
+		if got := l.Size(); got != n {
+			t.Errorf("Size() = %v, want %v", got, n)
+		}
+	}
+}
+
+func TestImmutableList_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	l := NewImmutableList[int]()
+	var want []int
+	versions := []*ImmutableList[int]{l}
+	snapshots := [][]int{nil}
+
+	for i := 0; i < 2000; i++ {
+		var err error
+		switch op := r.Intn(4); {
+		case op == 0 || len(want) == 0:
+			index := r.Intn(len(want) + 1)
+			l, err = l.Insert(index, i)
+			want = slices.Insert(slices.Clone(want), index, i)
+		case op == 1:
+			index := r.Intn(len(want))
+			l, err = l.RemoveAt(index)
+			want = slices.Delete(slices.Clone(want), index, index+1)
+		case op == 2:
+			index := r.Intn(len(want))
+			l, err = l.Set(index, i)
+			want = slices.Clone(want)
+			want[index] = i
+		default:
+			l = l.Add(i)
+			want = append(slices.Clone(want), i)
+		}
+		if err != nil {
+			t.Fatalf("operation %v failed: %v", i, err)
+		}
+		checkImmutableList(t, l.root, want)
+		if i%100 == 0 {
+			versions = append(versions, l)
+			snapshots = append(snapshots, want)
+		}
+	}
+
+	// Earlier versions are not affected by later changes.
+	for i, v := range versions {
+		checkImmutableList(t, v.root, snapshots[i])
+	}
+}
+
+func TestImmutableList_Errors(t *testing.T) {
+	l := NewImmutableList[string]("a", "b")
+	if got, err := l.Insert(3, "c"); err != ErrIndexOutOfRange || got != l {
+		t.Errorf("Insert(3) = %v, %v, want the same list and %v", got, err, ErrIndexOutOfRange)
+	}
+	if got, err := l.Set(2, "c"); err != ErrIndexOutOfRange || got != l {
+		t.Errorf("Set(2) = %v, %v, want the same list and %v", got, err, ErrIndexOutOfRange)
+	}
+	if got, err := l.RemoveAt(-1); err != ErrIndexOutOfRange || got != l {
+		t.Errorf("RemoveAt(-1) = %v, %v, want the same list and %v", got, err, ErrIndexOutOfRange)
+	}
+	if _, err := l.Get(2); err != ErrIndexOutOfRange {
+		t.Errorf("Get(2) error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if got, ok := l.Remove("z"); ok || got != l {
+		t.Errorf("Remove() = %v, %v, want the same list and false", got, ok)
+	}
+}
+
+func TestImmutableList_Search(t *testing.T) {
+	l := NewImmutableList[string]("a", "b", "a", "c")
+	if got := l.IndexOf("a"); got != 0 {
+		t.Errorf("IndexOf() = %v, want %v", got, 0)
+	}
+	if got := l.LastIndexOf("a"); got != 2 {
+		t.Errorf("LastIndexOf() = %v, want %v", got, 2)
+	}
+	if got := l.IndexOf("z"); got != -1 {
+		t.Errorf("IndexOf() = %v, want %v", got, -1)
+	}
+	if !l.Contains("c") || l.Contains("z") {
+		t.Errorf("Contains() does not match the list")
+	}
+
+	removed, ok := l.Remove("a")
+	if !ok || removed.String() != "[b a c]" {
+		t.Errorf("Remove() = %v, %v, want %v, %v", removed, ok, "[b a c]", true)
+	}
+
+	var backward []string
+	for i, v := range l.Backward() {
+		backward = append(backward, fmt.Sprint(i, v))
+	}
+	if want := []string{"3c", "2a", "1b", "0a"}; !reflect.DeepEqual(backward, want) {
+		t.Errorf("Backward() = %v, want %v", backward, want)
+	}
+
+	items := make([]string, 5)
+	if err := l.CopyTo(items, 1); err != nil || !reflect.DeepEqual(items[1:], []string{"a", "b", "a", "c"}) {
+		t.Errorf("CopyTo() = %v, %v", items, err)
+	}
+	if err := l.CopyTo(items, 2); err != ErrIndexOutOfRange {
+		t.Errorf("CopyTo() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if c := l.Clear(); !c.IsEmpty() || l.IsEmpty() {
+		t.Errorf("Clear() = %v, original = %v", c, l)
+	}
+}
+
+func TestImmutableListBuilder(t *testing.T) {
+	l1 := NewImmutableList[int](1, 2, 3)
+	b := l1.ToBuilder()
+	b.Add(4)
+	_ = b.Insert(0, 0)
+	_ = b.Set(1, 10)
+	_ = b.RemoveAt(2)
+	b.Remove(3)
+	l2 := b.ToImmutable()
+
+	checkImmutableList(t, l1.root, []int{1, 2, 3})
+	checkImmutableList(t, l2.root, []int{0, 10, 4})
+
+	// Changes made after ToImmutable do not affect the list it returned.
+	b.AddRange(5, 6)
+	_ = b.Set(0, 100)
+	checkImmutableList(t, l2.root, []int{0, 10, 4})
+	checkImmutableList(t, b.root, []int{100, 10, 4, 5, 6})
+
+	if got := b.Size(); got != 5 {
+		t.Errorf("Size() = %v, want %v", got, 5)
+	}
+	if got, _ := b.Get(3); got != 5 {
+		t.Errorf("Get(3) = %v, want %v", got, 5)
+	}
+	if err := b.Insert(6, 0); err != ErrIndexOutOfRange {
+		t.Errorf("Insert(6) error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	b.Clear()
+	if !b.IsEmpty() {
+		t.Errorf("Clear() left %v", b)
+	}
+}
+
+func TestImmutableListBuilder_InPlace(t *testing.T) {
+	b := NewImmutableList[int]().ToBuilder()
+	for i := 0; i < 100; i++ {
+		b.Add(i)
+	}
+	root := b.root
+
+	// The builder owns every node, so Set modifies the tree in place.
+	_ = b.Set(50, -1)
+	if b.root != root {
+		t.Errorf("Set() copied nodes owned by the builder")
+	}
+
+	l := b.ToImmutable()
+	_ = b.Set(50, -2)
+	if b.root == root {
+		t.Errorf("Set() modified nodes shared with an ImmutableList")
+	}
+	if got, _ := l.Get(50); got != -1 {
+		t.Errorf("Get(50) = %v, want %v", got, -1)
+	}
+}
+
+func BenchmarkImmutableList_Add(b *testing.B) {
+	l := NewImmutableList[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l = l.Add(i)
+	}
+}
+
+func BenchmarkImmutableListBuilder_Add(b *testing.B) {
+	builder := NewImmutableList[int]().ToBuilder()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		builder.Add(i)
+	}
+}
+
+func ExampleImmutableList() {
+	l1 := NewImmutableList[string]("a", "b", "c")
+	l2, _ := l1.Set(1, "x")
+	l3, _ := l2.Insert(0, "z")
+
+	fmt.Println(l1)
+	fmt.Println(l2)
+	fmt.Println(l3)
+	// Output:
+	// [a b c]
+	// [a x c]
+	// [z a x c]
+}
@@ -0,0 +1,251 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"sync"
+)
+
+// ImmutableQueue implements a FIFO data structure that cannot be modified.
+// Enqueue and Dequeue return a new queue and leave the original unchanged.
+// Because it never changes, an ImmutableQueue can be used from any number of
+// goroutines.
+//
+// The queue is a banker's queue made of two immutable stacks: items are
+// dequeued from the front stack and enqueued onto the back stack. When the
+// front stack runs out, the back stack is reversed to become the new front
+// stack. Enqueue takes constant time. Dequeue takes amortized constant time
+// only if each version of the queue is used at most once, as when a queue is
+// replaced by its result in a loop. The reversal is computed once per queue
+// and cached, so dequeuing the same queue more than once does not repeat it,
+// but every queue derived from an older version has its own back stack to
+// reverse. For example, if q has one item in front and n behind,
+// q.Enqueue(x).Dequeue() takes O(n) time for every x.
+//
+// To make many changes at once, use ToBuilder to get an
+// ImmutableQueueBuilder, and then ToImmutable to get the new queue.
+type ImmutableQueue[T any] struct {
+	front    *ImmutableStack[T]
+	back     *ImmutableStack[T]
+	reversed *ImmutableStack[T]
+	once     sync.Once
+}
+
+// NewImmutableQueue returns a new queue with the given initial items.
+func NewImmutableQueue[T comparable](values ...T) *ImmutableQueue[T] {
+	return NewImmutableQueueWithEqualityComparer(DefaultEqualityComparer[T], values...)
+}
+
+// NewImmutableQueueWithEqualityComparer returns a new queue with the given
+// initial items that uses the given comparer to find items.
+func NewImmutableQueueWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *ImmutableQueue[T] {
+	empty := &ImmutableStack[T]{comparer: comparer}
+	return &ImmutableQueue[T]{
+		front: NewImmutableStackWithEqualityComparer(comparer, values...).reverse(),
+		back:  empty,
+	}
+}
+
+// Enqueue returns a new queue with the given item added to the end.
+func (q *ImmutableQueue[T]) Enqueue(item T) *ImmutableQueue[T] {
+	if q.front.IsEmpty() {
+		return &ImmutableQueue[T]{front: q.front.Push(item), back: q.back}
+	}
+	return &ImmutableQueue[T]{front: q.front, back: q.back.Push(item)}
+}
+
+// Dequeue returns the item at the front of the queue and a new queue without
+// it. If the queue is empty, an error is returned along with the queue itself.
+func (q *ImmutableQueue[T]) Dequeue() (T, *ImmutableQueue[T], error) {
+	item, front, err := q.front.Pop()
+	if err != nil {
+		return item, q, ErrEmptyQueue
+	}
+
+	// The front stack is only empty when the whole queue is empty.
+	if front.IsEmpty() {
+		return item, &ImmutableQueue[T]{front: q.backReversed(), back: q.back.Clear()}, nil
+	}
+	return item, &ImmutableQueue[T]{front: front, back: q.back}, nil
+}
+
+// Peek returns the item at the front of the queue. If the queue is empty, an
+// error is returned.
+func (q *ImmutableQueue[T]) Peek() (T, error) {
+	item, err := q.front.Peek()
+	if err != nil {
+		return item, ErrEmptyQueue
+	}
+	return item, nil
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *ImmutableQueue[T]) IsEmpty() bool {
+	return q.front.IsEmpty()
+}
+
+// Size returns the number of items in the queue.
+func (q *ImmutableQueue[T]) Size() int {
+	return q.front.Size() + q.back.Size()
+}
+
+// Clear returns an empty queue that uses the same comparer.
+func (q *ImmutableQueue[T]) Clear() *ImmutableQueue[T] {
+	empty := q.front.Clear()
+	return &ImmutableQueue[T]{front: empty, back: empty}
+}
+
+// Contains returns true if the queue contains the given item.
+func (q *ImmutableQueue[T]) Contains(item T) bool {
+	return q.front.Contains(item) || q.back.Contains(item)
+}
+
+// CopyTo copies the items in the queue, from front to back, to the given
+// slice, starting at the given index. If the index is out of range, an error
+// is returned. If the slice is not large enough to hold all the items, an
+// error is returned.
+func (q *ImmutableQueue[T]) CopyTo(items []T, index int) error {
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < q.Size() {
+		return ErrIndexOutOfRange
+	}
+
+	for v := range q.Values() {
+		items[index] = v
+		index++
+	}
+
+	return nil
+}
+
+// Values returns an iterator over the items in the queue, from front to back.
+func (q *ImmutableQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range q.front.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+		for v := range q.backReversed().Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the queue.
+func (q *ImmutableQueue[T]) String() string {
+	items := make([]T, q.Size())
+	_ = q.CopyTo(items, 0)
+	return fmt.Sprintf("%v", items)
+}
+
+// ToBuilder returns a builder that starts with the items in the queue. The
+// builder shares its nodes with the queue, so creating it takes constant time.
+func (q *ImmutableQueue[T]) ToBuilder() *ImmutableQueueBuilder[T] {
+	return &ImmutableQueueBuilder[T]{front: *q.front.ToBuilder(), back: *q.back.ToBuilder()}
+}
+
+// backReversed returns the back stack in front-to-back order, computing it the
+// first time it is needed.
+func (q *ImmutableQueue[T]) backReversed() *ImmutableStack[T] {
+	q.once.Do(func() {
+		q.reversed = q.back.reverse()
+	})
+	return q.reversed
+}
+
+// ImmutableQueueBuilder builds an ImmutableQueue through a series of changes
+// made in place. It has the same methods as Queue, but unlike Queue, creating
+// a builder from an ImmutableQueue and getting an ImmutableQueue back from it
+// do not copy the items. It is not thread-safe.
+type ImmutableQueueBuilder[T any] struct {
+	// Like ImmutableQueue, the builder is a banker's queue, and its front
+	// stack is only empty when the whole queue is empty.
+	front ImmutableStackBuilder[T]
+	back  ImmutableStackBuilder[T]
+}
+
+// Enqueue adds an item to the end of the queue.
+func (b *ImmutableQueueBuilder[T]) Enqueue(item T) {
+	if b.front.IsEmpty() {
+		b.front.Push(item)
+		return
+	}
+	b.back.Push(item)
+}
+
+// Dequeue removes and returns the item at the front of the queue. If the queue
+// is empty, an error is returned.
+func (b *ImmutableQueueBuilder[T]) Dequeue() (T, error) {
+	item, err := b.front.Pop()
+	if err != nil {
+		return item, ErrEmptyQueue
+	}
+	if b.front.IsEmpty() {
+		b.front.top, b.front.size = b.back.top.reverse(), b.back.size
+		b.back.Clear()
+	}
+	return item, nil
+}
+
+// Peek returns the item at the front of the queue without removing it. If the
+// queue is empty, an error is returned.
+func (b *ImmutableQueueBuilder[T]) Peek() (T, error) {
+	item, err := b.front.Peek()
+	if err != nil {
+		return item, ErrEmptyQueue
+	}
+	return item, nil
+}
+
+// IsEmpty returns true if the queue is empty.
+func (b *ImmutableQueueBuilder[T]) IsEmpty() bool {
+	return b.front.IsEmpty()
+}
+
+// Size returns the number of items in the queue.
+func (b *ImmutableQueueBuilder[T]) Size() int {
+	return b.front.Size() + b.back.Size()
+}
+
+// Clear removes all items from the queue.
+func (b *ImmutableQueueBuilder[T]) Clear() {
+	b.front.Clear()
+	b.back.Clear()
+}
+
+// Contains returns true if the queue contains the given item.
+func (b *ImmutableQueueBuilder[T]) Contains(item T) bool {
+	return b.front.Contains(item) || b.back.Contains(item)
+}
+
+// CopyTo copies the items in the queue, from front to back, to the given
+// slice, starting at the given index. If the index is out of range, an error
+// is returned. If the slice is not large enough to hold all the items, an
+// error is returned.
+func (b *ImmutableQueueBuilder[T]) CopyTo(items []T, index int) error {
+	return b.ToImmutable().CopyTo(items, index)
+}
+
+// Values returns an iterator over the items in the queue, from front to back.
+// Changes made to the queue during iteration are not visible to the iterator.
+func (b *ImmutableQueueBuilder[T]) Values() iter.Seq[T] {
+	return b.ToImmutable().Values()
+}
+
+// String returns a string representation of the queue.
+func (b *ImmutableQueueBuilder[T]) String() string {
+	return b.ToImmutable().String()
+}
+
+// ToImmutable returns an ImmutableQueue with the items in the builder. Nodes
+// are never modified once pushed, so it takes constant time, and the builder
+// can still be used afterwards.
+func (b *ImmutableQueueBuilder[T]) ToImmutable() *ImmutableQueue[T] {
+	return &ImmutableQueue[T]{front: b.front.ToImmutable(), back: b.back.ToImmutable()}
+}
@@ -0,0 +1,163 @@
+package collections
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestNewImmutableQueue(t *testing.T) {
+	q := NewImmutableQueue[int](1, 2, 3)
+	if got := q.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+	if got, _ := q.Peek(); got != 1 {
+		t.Errorf("Peek() = %v, want %v", got, 1)
+	}
+	if got := q.String(); got != "[1 2 3]" {
+		t.Errorf("String() = %v, want %v", got, "[1 2 3]")
+	}
+}
+
+func TestImmutableQueue_Dequeue(t *testing.T) {
+	empty := NewImmutableQueue[int]()
+	if _, q, err := empty.Dequeue(); err != ErrEmptyQueue || q != empty {
+		t.Errorf("Dequeue() = %v, %v, want the same queue and %v", q, err, ErrEmptyQueue)
+	}
+	if _, err := empty.Peek(); err != ErrEmptyQueue {
+		t.Errorf("Peek() error = %v, want %v", err, ErrEmptyQueue)
+	}
+
+	// Interleave enqueues and dequeues so that the back stack is reversed
+	// several times.
+	q := empty
+	var want []int
+	for i := 0; i < 20; i++ {
+		q = q.Enqueue(i)
+		want = append(want, i)
+		if i%3 == 0 {
+			var item int
+			var err error
+			item, q, err = q.Dequeue()
+			if item != want[0] || err != nil {
+				t.Fatalf("Dequeue() = %v, %v, want %v, %v", item, err, want[0], nil)
+			}
+			want = want[1:]
+		}
+		if got := slices.Collect(q.Values()); !slices.Equal(got, want) {
+			t.Fatalf("Values() = %v, want %v", got, want)
+		}
+		if got := q.Size(); got != len(want) {
+			t.Fatalf("Size() = %v, want %v", got, len(want))
+		}
+	}
+}
+
+func TestImmutableQueue_Persistence(t *testing.T) {
+	q1 := NewImmutableQueue[string]("a").Enqueue("b")
+	_, q2, _ := q1.Dequeue()
+	q3 := q2.Enqueue("c")
+	q4 := q2.Enqueue("d")
+
+	tests := []struct {
+		q    *ImmutableQueue[string]
+		want string
+	}{
+		{q: q1, want: "[a b]"},
+		{q: q2, want: "[b]"},
+		{q: q3, want: "[b c]"},
+		{q: q4, want: "[b d]"},
+	}
+	for _, tt := range tests {
+		if got := tt.q.String(); got != tt.want {
+			t.Errorf("String() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestImmutableQueue_Concurrent(t *testing.T) {
+	q := NewImmutableQueue[int](1).Enqueue(2).Enqueue(3)
+
+	// The first Dequeue from each goroutine races to reverse the back stack.
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got := []int{}
+			for r := q; !r.IsEmpty(); {
+				var item int
+				item, r, _ = r.Dequeue()
+				got = append(got, item)
+			}
+			if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+				t.Errorf("Dequeue() = %v, want %v", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestImmutableQueue_Collection(t *testing.T) {
+	q := NewImmutableQueue[int](1, 2).Enqueue(3)
+	if !q.Contains(3) || !q.Contains(1) || q.Contains(4) {
+		t.Errorf("Contains() does not match the queue")
+	}
+
+	items := make([]int, 3)
+	if err := q.CopyTo(items, 0); err != nil || !reflect.DeepEqual(items, []int{1, 2, 3}) {
+		t.Errorf("CopyTo() = %v, %v, want %v, %v", items, err, []int{1, 2, 3}, nil)
+	}
+	if err := q.CopyTo(items, 1); err != ErrIndexOutOfRange {
+		t.Errorf("CopyTo() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	if c := q.Clear(); !c.IsEmpty() || c.Size() != 0 || q.IsEmpty() {
+		t.Errorf("Clear() = %v, original = %v", c, q)
+	}
+}
+
+func TestImmutableQueueBuilder(t *testing.T) {
+	q1 := NewImmutableQueue[int](1, 2)
+	b := q1.ToBuilder()
+
+	// Interleave enqueues and dequeues so that the back stack is reversed
+	// several times.
+	want := []int{1, 2}
+	for i := 3; i < 20; i++ {
+		b.Enqueue(i)
+		want = append(want, i)
+		if i%3 == 0 {
+			if got, err := b.Dequeue(); got != want[0] || err != nil {
+				t.Fatalf("Dequeue() = %v, %v, want %v, %v", got, err, want[0], nil)
+			}
+			want = want[1:]
+		}
+		if got := slices.Collect(b.Values()); !slices.Equal(got, want) {
+			t.Fatalf("Values() = %v, want %v", got, want)
+		}
+	}
+	q2 := b.ToImmutable()
+
+	if got := q1.String(); got != "[1 2]" {
+		t.Errorf("ToBuilder() changed the original queue to %v", got)
+	}
+	if got := slices.Collect(q2.Values()); !slices.Equal(got, want) {
+		t.Errorf("ToImmutable() = %v, want %v", got, want)
+	}
+
+	// Changes made after ToImmutable do not affect the queue it returned.
+	_, _ = b.Dequeue()
+	if got := q2.Size(); got != len(want) {
+		t.Errorf("Dequeue() after ToImmutable() changed the queue to %v", q2)
+	}
+
+	b.Clear()
+	if !b.IsEmpty() || b.Size() != 0 {
+		t.Errorf("Clear() left %v", b)
+	}
+	if _, err := b.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+}
@@ -0,0 +1,275 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+// immutableStackNode is a cell in the linked list that backs an
+// ImmutableStack. Nodes are never modified, so they can be shared by any
+// number of stacks.
+type immutableStackNode[T any] struct {
+	value T
+	next  *immutableStackNode[T]
+}
+
+// ImmutableStack implements a LIFO data structure that cannot be modified.
+// Push and Pop return a new stack and leave the original unchanged. The new
+// stack shares all but its top item with the original, so both operations take
+// constant time and memory. Because it never changes, an ImmutableStack can be
+// used from any number of goroutines.
+//
+// To make many changes at once, use ToBuilder to get an
+// ImmutableStackBuilder, and then ToImmutable to get the new stack.
+type ImmutableStack[T any] struct {
+	top      *immutableStackNode[T]
+	size     int
+	comparer EqualityComparer[T]
+}
+
+// NewImmutableStack returns a new stack with the given initial items, the last
+// of which is at the top.
+func NewImmutableStack[T comparable](values ...T) *ImmutableStack[T] {
+	return NewImmutableStackWithEqualityComparer(DefaultEqualityComparer[T], values...)
+}
+
+// NewImmutableStackWithEqualityComparer returns a new stack with the given
+// initial items, the last of which is at the top, that uses the given comparer
+// to find items.
+func NewImmutableStackWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *ImmutableStack[T] {
+	s := &ImmutableStack[T]{comparer: comparer}
+	for _, v := range values {
+		s.top = &immutableStackNode[T]{value: v, next: s.top}
+	}
+	s.size = len(values)
+	return s
+}
+
+// Push returns a new stack with the given item added to the top.
+func (s *ImmutableStack[T]) Push(item T) *ImmutableStack[T] {
+	return &ImmutableStack[T]{
+		top:      &immutableStackNode[T]{value: item, next: s.top},
+		size:     s.size + 1,
+		comparer: s.comparer,
+	}
+}
+
+// Pop returns the item at the top of the stack and a new stack without it. If
+// the stack is empty, an error is returned along with the stack itself.
+func (s *ImmutableStack[T]) Pop() (T, *ImmutableStack[T], error) {
+	if s.top == nil {
+		var zero T
+		return zero, s, ErrEmptyStack
+	}
+	return s.top.value, &ImmutableStack[T]{top: s.top.next, size: s.size - 1, comparer: s.comparer}, nil
+}
+
+// Peek returns the item at the top of the stack. If the stack is empty, an
+// error is returned.
+func (s *ImmutableStack[T]) Peek() (T, error) {
+	if s.top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+	return s.top.value, nil
+}
+
+// IsEmpty returns true if the stack is empty.
+func (s *ImmutableStack[T]) IsEmpty() bool {
+	return s.top == nil
+}
+
+// Size returns the number of items in the stack.
+func (s *ImmutableStack[T]) Size() int {
+	return s.size
+}
+
+// Clear returns an empty stack that uses the same comparer.
+func (s *ImmutableStack[T]) Clear() *ImmutableStack[T] {
+	return &ImmutableStack[T]{comparer: s.comparer}
+}
+
+// Contains returns true if the stack contains the given item.
+func (s *ImmutableStack[T]) Contains(item T) bool {
+	return s.top.contains(item, s.comparer)
+}
+
+// CopyTo copies the items in the stack, from top to bottom, to the given
+// slice, starting at the given index. If the index is out of range, an error
+// is returned. If the slice is not large enough to hold all the items, an
+// error is returned.
+func (s *ImmutableStack[T]) CopyTo(items []T, index int) error {
+	return s.top.copyTo(s.size, items, index)
+}
+
+// Values returns an iterator over the items in the stack, from top to bottom.
+func (s *ImmutableStack[T]) Values() iter.Seq[T] {
+	return s.top.values()
+}
+
+// String returns a string representation of the stack, from bottom to top,
+// like Stack.
+func (s *ImmutableStack[T]) String() string {
+	return s.top.format(s.size)
+}
+
+// ToBuilder returns a builder that starts with the items in the stack. The
+// builder shares its nodes with the stack, so creating it takes constant time.
+func (s *ImmutableStack[T]) ToBuilder() *ImmutableStackBuilder[T] {
+	return &ImmutableStackBuilder[T]{top: s.top, size: s.size, comparer: s.comparer}
+}
+
+// reverse returns a new stack with the items in the opposite order.
+func (s *ImmutableStack[T]) reverse() *ImmutableStack[T] {
+	return &ImmutableStack[T]{top: s.top.reverse(), size: s.size, comparer: s.comparer}
+}
+
+// ImmutableStackBuilder builds an ImmutableStack through a series of changes
+// made in place. It has the same methods as Stack, but unlike Stack, creating
+// a builder from an ImmutableStack and getting an ImmutableStack back from it
+// do not copy the items. It is not thread-safe.
+type ImmutableStackBuilder[T any] struct {
+	top      *immutableStackNode[T]
+	size     int
+	comparer EqualityComparer[T]
+}
+
+// Push adds an item to the top of the stack.
+func (b *ImmutableStackBuilder[T]) Push(item T) {
+	b.top = &immutableStackNode[T]{value: item, next: b.top}
+	b.size++
+}
+
+// Pop removes and returns the item at the top of the stack. If the stack is
+// empty, an error is returned.
+func (b *ImmutableStackBuilder[T]) Pop() (T, error) {
+	if b.top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+	item := b.top.value
+	b.top = b.top.next
+	b.size--
+	return item, nil
+}
+
+// Peek returns the item at the top of the stack without removing it. If the
+// stack is empty, an error is returned.
+func (b *ImmutableStackBuilder[T]) Peek() (T, error) {
+	if b.top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+	return b.top.value, nil
+}
+
+// IsEmpty returns true if the stack is empty.
+func (b *ImmutableStackBuilder[T]) IsEmpty() bool {
+	return b.top == nil
+}
+
+// Size returns the number of items in the stack.
+func (b *ImmutableStackBuilder[T]) Size() int {
+	return b.size
+}
+
+// Clear removes all items from the stack.
+func (b *ImmutableStackBuilder[T]) Clear() {
+	b.top = nil
+	b.size = 0
+}
+
+// Contains returns true if the stack contains the given item.
+func (b *ImmutableStackBuilder[T]) Contains(item T) bool {
+	return b.top.contains(item, b.comparer)
+}
+
+// CopyTo copies the items in the stack, from top to bottom, to the given
+// slice, starting at the given index. If the index is out of range, an error
+// is returned. If the slice is not large enough to hold all the items, an
+// error is returned.
+func (b *ImmutableStackBuilder[T]) CopyTo(items []T, index int) error {
+	return b.top.copyTo(b.size, items, index)
+}
+
+// Values returns an iterator over the items in the stack, from top to bottom.
+// Changes made to the stack during iteration are not visible to the iterator.
+func (b *ImmutableStackBuilder[T]) Values() iter.Seq[T] {
+	return b.top.values()
+}
+
+// String returns a string representation of the stack, from bottom to top.
+func (b *ImmutableStackBuilder[T]) String() string {
+	return b.top.format(b.size)
+}
+
+// ToImmutable returns an ImmutableStack with the items in the builder. Nodes
+// are never modified once pushed, so it takes constant time, and the builder
+// can still be used afterwards.
+func (b *ImmutableStackBuilder[T]) ToImmutable() *ImmutableStack[T] {
+	return &ImmutableStack[T]{top: b.top, size: b.size, comparer: b.comparer}
+}
+
+// contains returns true if the stack that starts at the node contains the
+// given item, according to the given comparer.
+func (n *immutableStackNode[T]) contains(item T, comparer EqualityComparer[T]) bool {
+	for ; n != nil; n = n.next {
+		if comparer(n.value, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyTo copies the size items of the stack that starts at the node, from top
+// to bottom, to the given slice, starting at the given index.
+func (n *immutableStackNode[T]) copyTo(size int, items []T, index int) error {
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < size {
+		return ErrIndexOutOfRange
+	}
+
+	for ; n != nil; n = n.next {
+		items[index] = n.value
+		index++
+	}
+
+	return nil
+}
+
+// values returns an iterator over the stack that starts at the node, from top
+// to bottom.
+func (n *immutableStackNode[T]) values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for ; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// format returns a string representation of the size items of the stack that
+// starts at the node, from bottom to top.
+func (n *immutableStackNode[T]) format(size int) string {
+	items := make([]T, size)
+	i := size - 1
+	for ; n != nil; n = n.next {
+		items[i] = n.value
+		i--
+	}
+	return fmt.Sprintf("%v", items)
+}
+
+// reverse returns the first node of a new stack that holds the items of the
+// stack that starts at the node in the opposite order.
+func (n *immutableStackNode[T]) reverse() *immutableStackNode[T] {
+	var r *immutableStackNode[T]
+	for ; n != nil; n = n.next {
+		r = &immutableStackNode[T]{value: n.value, next: r}
+	}
+	return r
+}
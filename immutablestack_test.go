@@ -0,0 +1,125 @@
+package collections
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewImmutableStack(t *testing.T) {
+	s := NewImmutableStack[int](1, 2, 3)
+	if got := s.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+	if got, _ := s.Peek(); got != 3 {
+		t.Errorf("Peek() = %v, want %v", got, 3)
+	}
+	if got := s.String(); got != "[1 2 3]" {
+		t.Errorf("String() = %v, want %v", got, "[1 2 3]")
+	}
+	if got := slices.Collect(s.Values()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Values() = %v, want %v", got, []int{3, 2, 1})
+	}
+}
+
+func TestImmutableStack_Push(t *testing.T) {
+	s1 := NewImmutableStack[string]("a")
+	s2 := s1.Push("b")
+	s3 := s1.Push("c")
+
+	if got := s1.String(); got != "[a]" {
+		t.Errorf("Push() changed the original stack to %v", got)
+	}
+	if got := s2.String(); got != "[a b]" {
+		t.Errorf("Push() = %v, want %v", got, "[a b]")
+	}
+	if got := s3.String(); got != "[a c]" {
+		t.Errorf("Push() = %v, want %v", got, "[a c]")
+	}
+}
+
+func TestImmutableStack_Pop(t *testing.T) {
+	empty := NewImmutableStack[string]()
+	if _, s, err := empty.Pop(); err != ErrEmptyStack || s != empty {
+		t.Errorf("Pop() = %v, %v, want the same stack and %v", s, err, ErrEmptyStack)
+	}
+
+	s1 := NewImmutableStack[string]("a", "b")
+	item, s2, err := s1.Pop()
+	if item != "b" || err != nil {
+		t.Errorf("Pop() = %v, %v, want %v, %v", item, err, "b", nil)
+	}
+	if got := s2.String(); got != "[a]" {
+		t.Errorf("Pop() stack = %v, want %v", got, "[a]")
+	}
+	if got := s1.Size(); got != 2 {
+		t.Errorf("Pop() changed the original stack to %v", s1)
+	}
+	if _, err := empty.Peek(); err != ErrEmptyStack {
+		t.Errorf("Peek() error = %v, want %v", err, ErrEmptyStack)
+	}
+}
+
+func TestImmutableStack_Collection(t *testing.T) {
+	s := NewImmutableStack[int](1, 2, 3)
+	if !s.Contains(2) || s.Contains(4) {
+		t.Errorf("Contains() does not match the stack")
+	}
+
+	items := make([]int, 4)
+	if err := s.CopyTo(items, 1); err != nil || !reflect.DeepEqual(items, []int{0, 3, 2, 1}) {
+		t.Errorf("CopyTo() = %v, %v, want %v, %v", items, err, []int{0, 3, 2, 1}, nil)
+	}
+	if err := s.CopyTo(items, 2); err != ErrIndexOutOfRange {
+		t.Errorf("CopyTo() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	if c := s.Clear(); !c.IsEmpty() || s.IsEmpty() {
+		t.Errorf("Clear() = %v, original = %v", c, s)
+	}
+
+	withComparer := NewImmutableStackWithEqualityComparer(func(a, b []int) bool {
+		return reflect.DeepEqual(a, b)
+	}, []int{1})
+	if !withComparer.Push([]int{2}).Contains([]int{1}) {
+		t.Errorf("Contains() did not use the comparer")
+	}
+}
+
+func TestImmutableStackBuilder(t *testing.T) {
+	s1 := NewImmutableStack[int](1, 2)
+	b := s1.ToBuilder()
+	b.Push(3)
+	b.Push(4)
+	if got, err := b.Pop(); got != 4 || err != nil {
+		t.Errorf("Pop() = %v, %v, want %v, %v", got, err, 4, nil)
+	}
+	s2 := b.ToImmutable()
+
+	if got := s1.String(); got != "[1 2]" {
+		t.Errorf("ToBuilder() changed the original stack to %v", got)
+	}
+	if got := s2.String(); got != "[1 2 3]" {
+		t.Errorf("ToImmutable() = %v, want %v", got, "[1 2 3]")
+	}
+
+	// Changes made after ToImmutable do not affect the stack it returned.
+	b.Push(5)
+	if got := s2.Size(); got != 3 {
+		t.Errorf("Push() after ToImmutable() changed the stack to %v", s2)
+	}
+	if !b.Contains(5) || s2.Contains(5) {
+		t.Errorf("Contains(5) = %v, %v, want %v, %v", b.Contains(5), s2.Contains(5), true, false)
+	}
+
+	b.Clear()
+	if !b.IsEmpty() || b.Size() != 0 {
+		t.Errorf("Clear() left %v", b)
+	}
+	if _, err := b.Peek(); err != ErrEmptyStack {
+		t.Errorf("Peek() error = %v, want %v", err, ErrEmptyStack)
+	}
+	if _, err := b.Pop(); err != ErrEmptyStack {
+		t.Errorf("Pop() error = %v, want %v", err, ErrEmptyStack)
+	}
+}
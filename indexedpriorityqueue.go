@@ -0,0 +1,222 @@
+package collections
+
+// PriorityQueueHandle refers to an element in an IndexedPriorityQueue. It is
+// returned by Enqueue and stays valid until the element is removed from the
+// queue, either by Dequeue, Remove or Clear.
+type PriorityQueueHandle[E any, P any] struct {
+	element  E
+	priority P
+	index    int
+	queue    *IndexedPriorityQueue[E, P]
+}
+
+// Element returns the element the handle refers to.
+func (h *PriorityQueueHandle[E, P]) Element() E {
+	return h.element
+}
+
+// Priority returns the priority of the element the handle refers to.
+func (h *PriorityQueueHandle[E, P]) Priority() P {
+	return h.priority
+}
+
+// IndexedPriorityQueue implements a queue of elements that each have a
+// separate priority, in which the element with the smallest priority, as
+// determined by a comparer, is always at the front. Unlike
+// ElementPriorityQueue, Enqueue returns a handle that can be used to change
+// the priority of the element or to remove it from anywhere in the queue in
+// O(log n) time. It is not thread-safe.
+type IndexedPriorityQueue[E any, P any] struct {
+	items    []*PriorityQueueHandle[E, P]
+	comparer Comparer[P]
+}
+
+// NewIndexedPriorityQueue returns a new, empty priority queue that orders
+// elements by their priority using the given comparer.
+func NewIndexedPriorityQueue[E any, P any](comparer Comparer[P]) *IndexedPriorityQueue[E, P] {
+	return &IndexedPriorityQueue[E, P]{comparer: comparer}
+}
+
+// Enqueue adds an element with the given priority to the queue and returns a
+// handle to it.
+func (q *IndexedPriorityQueue[E, P]) Enqueue(element E, priority P) *PriorityQueueHandle[E, P] {
+	h := &PriorityQueueHandle[E, P]{element: element, priority: priority, index: len(q.items), queue: q}
+	q.items = append(q.items, h)
+	q.up(h.index)
+	return h
+}
+
+// Dequeue removes and returns the element with the smallest priority, along
+// with its priority. If the queue is empty, an error is returned.
+func (q *IndexedPriorityQueue[E, P]) Dequeue() (E, P, error) {
+	if len(q.items) == 0 {
+		var element E
+		var priority P
+		return element, priority, ErrEmptyQueue
+	}
+
+	h := q.items[0]
+	q.removeAt(0)
+	return h.element, h.priority, nil
+}
+
+// Peek returns the element with the smallest priority, along with its
+// priority, without removing it. If the queue is empty, an error is returned.
+func (q *IndexedPriorityQueue[E, P]) Peek() (E, P, error) {
+	if len(q.items) == 0 {
+		var element E
+		var priority P
+		return element, priority, ErrEmptyQueue
+	}
+
+	h := q.items[0]
+	return h.element, h.priority, nil
+}
+
+// PeekHandle returns the handle of the element with the smallest priority
+// without removing it. If the queue is empty, an error is returned.
+func (q *IndexedPriorityQueue[E, P]) PeekHandle() (*PriorityQueueHandle[E, P], error) {
+	if len(q.items) == 0 {
+		return nil, ErrEmptyQueue
+	}
+	return q.items[0], nil
+}
+
+// Contains returns true if the handle refers to an element in the queue.
+func (q *IndexedPriorityQueue[E, P]) Contains(h *PriorityQueueHandle[E, P]) bool {
+	return h != nil && h.queue == q
+}
+
+// UpdatePriority changes the priority of the element the handle refers to. If
+// the handle does not refer to an element in the queue, ErrInvalidHandle is
+// returned.
+func (q *IndexedPriorityQueue[E, P]) UpdatePriority(h *PriorityQueueHandle[E, P], priority P) error {
+	if !q.Contains(h) {
+		return ErrInvalidHandle
+	}
+
+	h.priority = priority
+	if !q.up(h.index) {
+		q.down(h.index)
+	}
+	return nil
+}
+
+// Remove removes the element the handle refers to from the queue. If the
+// handle does not refer to an element in the queue, ErrInvalidHandle is
+// returned.
+func (q *IndexedPriorityQueue[E, P]) Remove(h *PriorityQueueHandle[E, P]) error {
+	if !q.Contains(h) {
+		return ErrInvalidHandle
+	}
+
+	q.removeAt(h.index)
+	return nil
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *IndexedPriorityQueue[E, P]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+// Size returns the number of elements in the queue.
+func (q *IndexedPriorityQueue[E, P]) Size() int {
+	return len(q.items)
+}
+
+// Clear removes all elements from the queue. Every handle returned by the
+// queue becomes invalid.
+func (q *IndexedPriorityQueue[E, P]) Clear() {
+	for _, h := range q.items {
+		h.queue = nil
+		h.index = -1
+	}
+	q.items = nil
+}
+
+// CopyTo copies the elements in the queue to the given slice, starting at the
+// given index. The elements are not copied in any particular order. If the
+// index is out of range, an error is returned. If the slice is not large
+// enough to hold all the elements, an error is returned.
+func (q *IndexedPriorityQueue[E, P]) CopyTo(elements []E, index int) error {
+	if index < 0 || index > len(elements) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(elements)-index < len(q.items) {
+		return ErrIndexOutOfRange
+	}
+
+	for i, h := range q.items {
+		elements[index+i] = h.element
+	}
+
+	return nil
+}
+
+// removeAt removes the element at the given position in the heap and
+// invalidates its handle.
+func (q *IndexedPriorityQueue[E, P]) removeAt(i int) {
+	h := q.items[i]
+	n := len(q.items) - 1
+	if i != n {
+		q.swap(i, n)
+	}
+	q.items[n] = nil
+	q.items = q.items[:n]
+	if i != n && !q.up(i) {
+		q.down(i)
+	}
+
+	h.queue = nil
+	h.index = -1
+}
+
+// less returns true if the element at position i has a smaller priority than
+// the element at position j.
+func (q *IndexedPriorityQueue[E, P]) less(i, j int) bool {
+	return q.comparer(q.items[i].priority, q.items[j].priority) < 0
+}
+
+// swap swaps the elements at positions i and j, keeping their handles in
+// sync.
+func (q *IndexedPriorityQueue[E, P]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
+
+// up moves the element at position i up the heap and returns true if it
+// moved.
+func (q *IndexedPriorityQueue[E, P]) up(i int) bool {
+	start := i
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(i, parent) {
+			break
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+	return i != start
+}
+
+// down moves the element at position i down the heap.
+func (q *IndexedPriorityQueue[E, P]) down(i int) {
+	n := len(q.items)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && q.less(left, smallest) {
+			smallest = left
+		}
+		if right < n && q.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		q.swap(i, smallest)
+		i = smallest
+	}
+}
@@ -0,0 +1,237 @@
+package collections
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// checkIndexedHeap verifies the heap property and that every handle knows its
+// position in the heap.
+func checkIndexedHeap[E any, P any](t *testing.T, q *IndexedPriorityQueue[E, P]) {
+	t.Helper()
+	for i, h := range q.items {
+		if h.index != i {
+			t.Fatalf("handle at position %d has index %d", i, h.index)
+		}
+		if h.queue != q {
+			t.Fatalf("handle at position %d belongs to another queue", i)
+		}
+		if i > 0 && q.less(i, (i-1)/2) {
+			t.Fatalf("heap property violated at position %d", i)
+		}
+	}
+}
+
+func TestIndexedPriorityQueue_Dequeue(t *testing.T) {
+	q := NewIndexedPriorityQueue[string, int](compareInts)
+	q.Enqueue("c", 3)
+	q.Enqueue("a", 1)
+	q.Enqueue("b", 2)
+	checkIndexedHeap(t, q)
+
+	for _, want := range []string{"a", "b", "c"} {
+		got, _, err := q.Dequeue()
+		if got != want || err != nil {
+			t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, want, nil)
+		}
+	}
+	if _, _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	if _, _, err := q.Peek(); err != ErrEmptyQueue {
+		t.Errorf("Peek() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	if _, err := q.PeekHandle(); err != ErrEmptyQueue {
+		t.Errorf("PeekHandle() error = %v, want %v", err, ErrEmptyQueue)
+	}
+}
+
+func TestIndexedPriorityQueue_UpdatePriority(t *testing.T) {
+	tests := []struct {
+		name     string
+		element  string
+		priority int
+		want     []string
+	}{
+		{name: "Decrease", element: "c", priority: 0, want: []string{"c", "a", "b", "d"}},
+		{name: "Increase", element: "a", priority: 10, want: []string{"b", "c", "d", "a"}},
+		{name: "Same", element: "b", priority: 2, want: []string{"a", "b", "c", "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewIndexedPriorityQueue[string, int](compareInts)
+			handles := map[string]*PriorityQueueHandle[string, int]{}
+			for i, e := range []string{"a", "b", "c", "d"} {
+				handles[e] = q.Enqueue(e, i+1)
+			}
+
+			if err := q.UpdatePriority(handles[tt.element], tt.priority); err != nil {
+				t.Fatalf("UpdatePriority() error = %v", err)
+			}
+			if got := handles[tt.element].Priority(); got != tt.priority {
+				t.Errorf("Priority() = %v, want %v", got, tt.priority)
+			}
+			checkIndexedHeap(t, q)
+
+			var got []string
+			for !q.IsEmpty() {
+				e, _, _ := q.Dequeue()
+				got = append(got, e)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Dequeue() order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexedPriorityQueue_Remove(t *testing.T) {
+	q := NewIndexedPriorityQueue[string, int](compareInts)
+	a := q.Enqueue("a", 1)
+	b := q.Enqueue("b", 2)
+	c := q.Enqueue("c", 3)
+
+	if err := q.Remove(b); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if q.Contains(b) {
+		t.Errorf("Contains() = true after Remove()")
+	}
+	if !q.Contains(a) || !q.Contains(c) {
+		t.Errorf("Remove() invalidated other handles")
+	}
+	checkIndexedHeap(t, q)
+
+	if got := q.Size(); got != 2 {
+		t.Errorf("Size() = %v, want %v", got, 2)
+	}
+	if err := q.Remove(b); err != ErrInvalidHandle {
+		t.Errorf("Remove() error = %v, want %v", err, ErrInvalidHandle)
+	}
+	if err := q.UpdatePriority(b, 0); err != ErrInvalidHandle {
+		t.Errorf("UpdatePriority() error = %v, want %v", err, ErrInvalidHandle)
+	}
+	if got := b.Element(); got != "b" {
+		t.Errorf("Element() = %v, want %v", got, "b")
+	}
+}
+
+func TestIndexedPriorityQueue_InvalidHandles(t *testing.T) {
+	q := NewIndexedPriorityQueue[string, int](compareInts)
+	other := NewIndexedPriorityQueue[string, int](compareInts)
+	foreign := other.Enqueue("x", 1)
+	dequeued := q.Enqueue("a", 1)
+	_, _, _ = q.Dequeue()
+	cleared := q.Enqueue("b", 2)
+	q.Clear()
+
+	tests := []struct {
+		name   string
+		handle *PriorityQueueHandle[string, int]
+	}{
+		{name: "Nil", handle: nil},
+		{name: "Foreign", handle: foreign},
+		{name: "Dequeued", handle: dequeued},
+		{name: "Cleared", handle: cleared},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if q.Contains(tt.handle) {
+				t.Errorf("Contains() = true, want false")
+			}
+			if err := q.UpdatePriority(tt.handle, 0); err != ErrInvalidHandle {
+				t.Errorf("UpdatePriority() error = %v, want %v", err, ErrInvalidHandle)
+			}
+			if err := q.Remove(tt.handle); err != ErrInvalidHandle {
+				t.Errorf("Remove() error = %v, want %v", err, ErrInvalidHandle)
+			}
+		})
+	}
+	if !other.Contains(foreign) {
+		t.Errorf("Contains() = false for a handle in its own queue")
+	}
+}
+
+func TestIndexedPriorityQueue_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	q := NewIndexedPriorityQueue[int, int](compareInts)
+	live := map[int]*PriorityQueueHandle[int, int]{}
+
+	for i := 0; i < 2000; i++ {
+		switch r.Intn(4) {
+		case 0, 1:
+			live[i] = q.Enqueue(i, r.Intn(1000))
+		case 2:
+			for _, h := range live {
+				if err := q.UpdatePriority(h, r.Intn(1000)); err != nil {
+					t.Fatalf("UpdatePriority() error = %v", err)
+				}
+				break
+			}
+		case 3:
+			for k, h := range live {
+				if err := q.Remove(h); err != nil {
+					t.Fatalf("Remove() error = %v", err)
+				}
+				delete(live, k)
+				break
+			}
+		}
+		checkIndexedHeap(t, q)
+	}
+
+	var want []int
+	for _, h := range live {
+		want = append(want, h.Priority())
+	}
+	sort.Ints(want)
+
+	var got []int
+	for !q.IsEmpty() {
+		_, p, _ := q.Dequeue()
+		got = append(got, p)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Dequeue() priorities = %v, want %v", got, want)
+	}
+}
+
+func TestIndexedPriorityQueue_CopyTo(t *testing.T) {
+	q := NewIndexedPriorityQueue[string, int](compareInts)
+	q.Enqueue("b", 2)
+	q.Enqueue("a", 1)
+
+	elements := make([]string, 3)
+	if err := q.CopyTo(elements, 1); err != nil {
+		t.Fatalf("CopyTo() error = %v", err)
+	}
+	got := elements[1:]
+	sort.Strings(got)
+	if fmt.Sprint(got) != "[a b]" {
+		t.Errorf("CopyTo() got = %v, want %v", got, "[a b]")
+	}
+	if err := q.CopyTo(elements, 2); err != ErrIndexOutOfRange {
+		t.Errorf("CopyTo() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+}
+
+func ExampleIndexedPriorityQueue() {
+	queue := NewIndexedPriorityQueue[string, int](func(a, b int) int { return a - b })
+	queue.Enqueue("backup", 30)
+	report := queue.Enqueue("report", 20)
+	queue.Enqueue("cleanup", 10)
+
+	// The report is now due first
+	_ = queue.UpdatePriority(report, 5)
+
+	for !queue.IsEmpty() {
+		job, due, _ := queue.Dequeue()
+		fmt.Println(due, job)
+	}
+	// Output:
+	// 5 report
+	// 10 cleanup
+	// 30 backup
+}
@@ -0,0 +1,318 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+// LinkedListNode is a node in a LinkedList. It is returned by the methods that
+// add items to the list, and stays valid until it is removed from the list,
+// either by Remove or Clear.
+type LinkedListNode[T any] struct {
+	// Value is the item stored in the node.
+	Value T
+
+	next *LinkedListNode[T]
+	prev *LinkedListNode[T]
+	list *LinkedList[T]
+}
+
+// Next returns the node after this one, or nil if this is the last node or
+// the node is not in a list.
+func (n *LinkedListNode[T]) Next() *LinkedListNode[T] {
+	return n.next
+}
+
+// Prev returns the node before this one, or nil if this is the first node or
+// the node is not in a list.
+func (n *LinkedListNode[T]) Prev() *LinkedListNode[T] {
+	return n.prev
+}
+
+// List returns the list the node belongs to, or nil if the node has been
+// removed.
+func (n *LinkedListNode[T]) List() *LinkedList[T] {
+	return n.list
+}
+
+// LinkedList implements a doubly linked list. It is modelled on .NET's
+// LinkedList<T>. Items can be added, removed and moved anywhere in the list in
+// constant time, given the node to work from. Finding an item takes O(n)
+// time. It is not thread-safe.
+//
+// Methods that take a node return ErrInvalidHandle if the node does not belong
+// to the list, either because it belongs to another list or because it has
+// been removed.
+//
+// The zero value is an empty list ready to use, but Find, FindLast and
+// Contains need the comparer given to NewLinkedList or
+// NewLinkedListWithEqualityComparer.
+type LinkedList[T any] struct {
+	head     *LinkedListNode[T]
+	tail     *LinkedListNode[T]
+	size     int
+	comparer EqualityComparer[T]
+}
+
+// NewLinkedList returns a new list with the given initial items, compared with
+// the == operator.
+func NewLinkedList[T comparable](values ...T) *LinkedList[T] {
+	return NewLinkedListWithEqualityComparer(DefaultEqualityComparer[T], values...)
+}
+
+// NewLinkedListWithEqualityComparer returns a new list with the given initial
+// items, compared with the given comparer.
+func NewLinkedListWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *LinkedList[T] {
+	l := &LinkedList[T]{comparer: comparer}
+	for _, v := range values {
+		l.AddLast(v)
+	}
+	return l
+}
+
+// First returns the first node in the list, or nil if the list is empty.
+func (l *LinkedList[T]) First() *LinkedListNode[T] {
+	return l.head
+}
+
+// Last returns the last node in the list, or nil if the list is empty.
+func (l *LinkedList[T]) Last() *LinkedListNode[T] {
+	return l.tail
+}
+
+// AddFirst adds an item to the start of the list and returns its node.
+func (l *LinkedList[T]) AddFirst(item T) *LinkedListNode[T] {
+	n := &LinkedListNode[T]{Value: item}
+	l.link(n, nil, l.head)
+	return n
+}
+
+// AddLast adds an item to the end of the list and returns its node.
+func (l *LinkedList[T]) AddLast(item T) *LinkedListNode[T] {
+	n := &LinkedListNode[T]{Value: item}
+	l.link(n, l.tail, nil)
+	return n
+}
+
+// AddBefore adds an item before the given node and returns its node. If the
+// node does not belong to the list, ErrInvalidHandle is returned.
+func (l *LinkedList[T]) AddBefore(node *LinkedListNode[T], item T) (*LinkedListNode[T], error) {
+	if !l.owns(node) {
+		return nil, ErrInvalidHandle
+	}
+
+	n := &LinkedListNode[T]{Value: item}
+	l.link(n, node.prev, node)
+	return n, nil
+}
+
+// AddAfter adds an item after the given node and returns its node. If the
+// node does not belong to the list, ErrInvalidHandle is returned.
+func (l *LinkedList[T]) AddAfter(node *LinkedListNode[T], item T) (*LinkedListNode[T], error) {
+	if !l.owns(node) {
+		return nil, ErrInvalidHandle
+	}
+
+	n := &LinkedListNode[T]{Value: item}
+	l.link(n, node, node.next)
+	return n, nil
+}
+
+// Remove removes the given node from the list. If the node does not belong to
+// the list, ErrInvalidHandle is returned.
+func (l *LinkedList[T]) Remove(node *LinkedListNode[T]) error {
+	if !l.owns(node) {
+		return ErrInvalidHandle
+	}
+
+	l.unlink(node)
+	return nil
+}
+
+// MoveToFront moves the given node to the start of the list. If the node does
+// not belong to the list, ErrInvalidHandle is returned.
+func (l *LinkedList[T]) MoveToFront(node *LinkedListNode[T]) error {
+	if !l.owns(node) {
+		return ErrInvalidHandle
+	}
+
+	if node != l.head {
+		l.unlink(node)
+		l.link(node, nil, l.head)
+	}
+	return nil
+}
+
+// MoveToBack moves the given node to the end of the list. If the node does not
+// belong to the list, ErrInvalidHandle is returned.
+func (l *LinkedList[T]) MoveToBack(node *LinkedListNode[T]) error {
+	if !l.owns(node) {
+		return ErrInvalidHandle
+	}
+
+	if node != l.tail {
+		l.unlink(node)
+		l.link(node, l.tail, nil)
+	}
+	return nil
+}
+
+// Find returns the first node that contains the given item. If the item is not
+// found, nil is returned.
+func (l *LinkedList[T]) Find(item T) *LinkedListNode[T] {
+	for n := l.head; n != nil; n = n.next {
+		if l.comparer(n.Value, item) {
+			return n
+		}
+	}
+	return nil
+}
+
+// FindLast returns the last node that contains the given item. If the item is
+// not found, nil is returned.
+func (l *LinkedList[T]) FindLast(item T) *LinkedListNode[T] {
+	for n := l.tail; n != nil; n = n.prev {
+		if l.comparer(n.Value, item) {
+			return n
+		}
+	}
+	return nil
+}
+
+// Contains returns true if the list contains the given item.
+func (l *LinkedList[T]) Contains(item T) bool {
+	return l.Find(item) != nil
+}
+
+// Size returns the number of items in the list.
+func (l *LinkedList[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty returns true if the list is empty.
+func (l *LinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Clear removes all items from the list. Every node in the list becomes
+// invalid, so it takes O(n) time.
+func (l *LinkedList[T]) Clear() {
+	for n := l.head; n != nil; {
+		next := n.next
+		n.next, n.prev, n.list = nil, nil, nil
+		n = next
+	}
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+// CopyTo copies the items in the list, from first to last, to the given
+// slice, starting at the given index. If the index is out of range, an error is
+// returned. If the slice is not large enough to hold all the items, an error is
+// returned.
+func (l *LinkedList[T]) CopyTo(items []T, index int) error {
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < l.size {
+		return ErrIndexOutOfRange
+	}
+
+	for n := l.head; n != nil; n = n.next {
+		items[index] = n.Value
+		index++
+	}
+
+	return nil
+}
+
+// Nodes returns an iterator over the nodes in the list, from first to last.
+// The node being visited may be removed during iteration, and iteration
+// continues with the node that followed it. The list should not be modified
+// in any other way during iteration.
+func (l *LinkedList[T]) Nodes() iter.Seq[*LinkedListNode[T]] {
+	return func(yield func(*LinkedListNode[T]) bool) {
+		for n := l.head; n != nil; {
+			next := n.next
+			if !yield(n) {
+				return
+			}
+			n = next
+		}
+	}
+}
+
+// Values returns an iterator over the items in the list, from first to last.
+// The node being visited may be removed during iteration, and iteration
+// continues with the node that followed it. The list should not be modified
+// in any other way during iteration.
+func (l *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := range l.Nodes() {
+			if !yield(n.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the items in the list, from last to
+// first. The node being visited may be removed during iteration, and
+// iteration continues with the node that preceded it. The list should not be
+// modified in any other way during iteration.
+func (l *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.tail; n != nil; {
+			prev := n.prev
+			if !yield(n.Value) {
+				return
+			}
+			n = prev
+		}
+	}
+}
+
+// String returns a string representation of the list, from first to last.
+func (l *LinkedList[T]) String() string {
+	items := make([]T, l.size)
+	_ = l.CopyTo(items, 0)
+	return fmt.Sprintf("%v", items)
+}
+
+// owns returns true if the node belongs to the list.
+func (l *LinkedList[T]) owns(node *LinkedListNode[T]) bool {
+	return node != nil && node.list == l
+}
+
+// link inserts the node between prev and next, either of which may be nil at
+// the ends of the list.
+func (l *LinkedList[T]) link(n *LinkedListNode[T], prev *LinkedListNode[T], next *LinkedListNode[T]) {
+	n.prev, n.next, n.list = prev, next, l
+	if prev == nil {
+		l.head = n
+	} else {
+		prev.next = n
+	}
+	if next == nil {
+		l.tail = n
+	} else {
+		next.prev = n
+	}
+	l.size++
+}
+
+// unlink removes the node from the list.
+func (l *LinkedList[T]) unlink(n *LinkedListNode[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.next, n.prev, n.list = nil, nil, nil
+	l.size--
+}
@@ -0,0 +1,210 @@
+package collections
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// checkLinkedList fails the test if the links of the list are inconsistent or
+// its items are not the given items.
+func checkLinkedList[T comparable](t *testing.T, l *LinkedList[T], want ...T) {
+	t.Helper()
+
+	var forward []T
+	var prev *LinkedListNode[T]
+	for n := l.First(); n != nil; n = n.Next() {
+		if n.Prev() != prev || n.List() != l {
+			t.Fatalf("node %v is not linked to the list correctly", n.Value)
+		}
+		forward = append(forward, n.Value)
+		prev = n
+	}
+	if prev != l.Last() {
+		t.Fatalf("Last() = %v, want the last node", l.Last())
+	}
+	if !slices.Equal(forward, want) {
+		t.Fatalf("list = %v, want %v", forward, want)
+	}
+	if got := slices.Collect(l.Backward()); !slices.Equal(got, reversed(want)) {
+		t.Fatalf("Backward() = %v, want %v", got, reversed(want))
+	}
+	if got := l.Size(); got != len(want) {
+		t.Fatalf("Size() = %v, want %v", got, len(want))
+	}
+}
+
+func TestLinkedList_Add(t *testing.T) {
+	l := NewLinkedList[int]()
+	checkLinkedList(t, l)
+	if l.First() != nil || l.Last() != nil || !l.IsEmpty() {
+		t.Errorf("a new list is not empty")
+	}
+
+	two := l.AddFirst(2)
+	l.AddFirst(1)
+	four := l.AddLast(4)
+	checkLinkedList(t, l, 1, 2, 4)
+
+	if _, err := l.AddAfter(two, 3); err != nil {
+		t.Fatalf("AddAfter() error = %v", err)
+	}
+	if _, err := l.AddAfter(four, 5); err != nil {
+		t.Fatalf("AddAfter() error = %v", err)
+	}
+	zero, err := l.AddBefore(l.First(), 0)
+	if err != nil {
+		t.Fatalf("AddBefore() error = %v", err)
+	}
+	if _, err := l.AddBefore(four, 3); err != nil {
+		t.Fatalf("AddBefore() error = %v", err)
+	}
+	checkLinkedList(t, l, 0, 1, 2, 3, 3, 4, 5)
+
+	if l.First() != zero {
+		t.Errorf("First() = %v, want %v", l.First().Value, 0)
+	}
+	if got := l.String(); got != "[0 1 2 3 3 4 5]" {
+		t.Errorf("String() = %v, want %v", got, "[0 1 2 3 3 4 5]")
+	}
+}
+
+func TestLinkedList_Remove(t *testing.T) {
+	l := NewLinkedList[int](1, 2, 3, 4)
+
+	for _, v := range []int{1, 4, 2} {
+		n := l.Find(v)
+		if err := l.Remove(n); err != nil {
+			t.Fatalf("Remove(%v) error = %v", v, err)
+		}
+		if n.List() != nil || n.Next() != nil || n.Prev() != nil {
+			t.Errorf("Remove(%v) left the node linked", v)
+		}
+		if err := l.Remove(n); err != ErrInvalidHandle {
+			t.Errorf("Remove(%v) again error = %v, want %v", v, err, ErrInvalidHandle)
+		}
+	}
+	checkLinkedList(t, l, 3)
+
+	if err := l.Remove(nil); err != ErrInvalidHandle {
+		t.Errorf("Remove(nil) error = %v, want %v", err, ErrInvalidHandle)
+	}
+
+	n := l.First()
+	l.Clear()
+	checkLinkedList(t, l)
+	if err := l.MoveToFront(n); err != ErrInvalidHandle {
+		t.Errorf("MoveToFront() after Clear() error = %v, want %v", err, ErrInvalidHandle)
+	}
+}
+
+func TestLinkedList_Move(t *testing.T) {
+	l := NewLinkedList[int](1, 2, 3)
+
+	tests := []struct {
+		name string
+		move func(*LinkedListNode[int]) error
+		item int
+		want []int
+	}{
+		{name: "MoveToFront/Last", move: l.MoveToFront, item: 3, want: []int{3, 1, 2}},
+		{name: "MoveToFront/Middle", move: l.MoveToFront, item: 1, want: []int{1, 3, 2}},
+		{name: "MoveToFront/First", move: l.MoveToFront, item: 1, want: []int{1, 3, 2}},
+		{name: "MoveToBack/First", move: l.MoveToBack, item: 1, want: []int{3, 2, 1}},
+		{name: "MoveToBack/Middle", move: l.MoveToBack, item: 2, want: []int{3, 1, 2}},
+		{name: "MoveToBack/Last", move: l.MoveToBack, item: 2, want: []int{3, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.move(l.Find(tt.item)); err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			checkLinkedList(t, l, tt.want...)
+		})
+	}
+}
+
+func TestLinkedList_OtherList(t *testing.T) {
+	l := NewLinkedList[int](1, 2)
+	other := NewLinkedList[int](3)
+	n := other.First()
+
+	if _, err := l.AddBefore(n, 0); err != ErrInvalidHandle {
+		t.Errorf("AddBefore() error = %v, want %v", err, ErrInvalidHandle)
+	}
+	if _, err := l.AddAfter(n, 0); err != ErrInvalidHandle {
+		t.Errorf("AddAfter() error = %v, want %v", err, ErrInvalidHandle)
+	}
+	if err := l.Remove(n); err != ErrInvalidHandle {
+		t.Errorf("Remove() error = %v, want %v", err, ErrInvalidHandle)
+	}
+	if err := l.MoveToFront(n); err != ErrInvalidHandle {
+		t.Errorf("MoveToFront() error = %v, want %v", err, ErrInvalidHandle)
+	}
+	if err := l.MoveToBack(n); err != ErrInvalidHandle {
+		t.Errorf("MoveToBack() error = %v, want %v", err, ErrInvalidHandle)
+	}
+	checkLinkedList(t, l, 1, 2)
+	checkLinkedList(t, other, 3)
+}
+
+func TestLinkedList_Find(t *testing.T) {
+	l := NewLinkedList[string]("a", "b", "a", "c")
+
+	first, last := l.Find("a"), l.FindLast("a")
+	if first != l.First() || last != l.First().Next().Next() {
+		t.Errorf("Find() or FindLast() returned the wrong node")
+	}
+	if l.Find("d") != nil || l.FindLast("d") != nil {
+		t.Errorf("Find(d) or FindLast(d) is not nil")
+	}
+
+	s := NewLinkedListWithEqualityComparer(slices.Equal[[]int], []int{1}, []int{2}, []int{1})
+	if n := s.FindLast([]int{1}); n != s.Last() {
+		t.Errorf("FindLast([1]) = %v, want the last node", n)
+	}
+}
+
+func TestLinkedList_Values(t *testing.T) {
+	l := NewLinkedList[int](1, 2, 3, 4, 5)
+
+	// The node being visited may be removed during iteration.
+	for n := range l.Nodes() {
+		if n.Value%2 == 0 {
+			_ = l.Remove(n)
+		}
+	}
+	checkLinkedList(t, l, 1, 3, 5)
+
+	var got []int
+	for v := range l.Values() {
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("Values() = %v, want %v", got, []int{1, 3})
+	}
+}
+
+func BenchmarkLinkedList_MoveToFront(b *testing.B) {
+	l := NewLinkedList[int]()
+	for i := 0; i < 1000; i++ {
+		l.AddLast(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = l.MoveToFront(l.Last())
+	}
+}
+
+func ExampleLinkedList_MoveToFront() {
+	l := NewLinkedList[string]("a", "b", "c")
+	_ = l.MoveToFront(l.Find("c"))
+	_, _ = l.AddAfter(l.First(), "x")
+
+	fmt.Println(l)
+	// Output: [c x a b]
+}
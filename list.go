@@ -2,6 +2,9 @@ package collections
 
 import (
 	"fmt"
+	"iter"
+	"slices"
+	"sort"
 	"sync"
 )
 
@@ -67,6 +70,16 @@ func (l *List[T]) RemoveAt(index int) error {
 	return nil
 }
 
+// Size returns the number of items in the list.
+func (l *List[T]) Size() int {
+	return len(l.items)
+}
+
+// IsEmpty returns true if the list is empty.
+func (l *List[T]) IsEmpty() bool {
+	return len(l.items) == 0
+}
+
 // Clear removes all items from the list.
 func (l *List[T]) Clear() {
 	l.items = []T{}
@@ -135,10 +148,242 @@ func (l *List[T]) Set(index int, item T) error {
 	return nil
 }
 
+// Sort sorts the items in the list using the given comparer. The sort is not
+// guaranteed to be stable.
+func (l *List[T]) Sort(comparer Comparer[T]) {
+	sort.Sort(comparerSorter[T]{items: l.items, comparer: comparer})
+}
+
+// SortStable sorts the items in the list using the given comparer, keeping
+// equal items in their original order.
+func (l *List[T]) SortStable(comparer Comparer[T]) {
+	sort.Stable(comparerSorter[T]{items: l.items, comparer: comparer})
+}
+
+// SortRange sorts count items, starting at the given index, using the given
+// comparer.
+func (l *List[T]) SortRange(index int, count int, comparer Comparer[T]) error {
+	if index < 0 || count < 0 || index+count > len(l.items) {
+		return ErrIndexOutOfRange
+	}
+
+	sort.Sort(comparerSorter[T]{items: l.items[index : index+count], comparer: comparer})
+	return nil
+}
+
+// BinarySearch searches the sorted list for the given item using the given
+// comparer. If the item is found, its index is returned. If the item is not
+// found, the bitwise complement of the index at which it would be inserted is
+// returned.
+func (l *List[T]) BinarySearch(item T, comparer Comparer[T]) int {
+	return binarySearch(l.items, item, comparer)
+}
+
+// Find returns the first item that matches the given predicate. If no item
+// matches, the zero value and false are returned.
+func (l *List[T]) Find(match Predicate[T]) (T, bool) {
+	for _, v := range l.items {
+		if match(v) {
+			return v, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// FindLast returns the last item that matches the given predicate. If no item
+// matches, the zero value and false are returned.
+func (l *List[T]) FindLast(match Predicate[T]) (T, bool) {
+	for i := len(l.items) - 1; i >= 0; i-- {
+		if match(l.items[i]) {
+			return l.items[i], true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// FindIndex returns the index of the first item that matches the given
+// predicate, searching count items starting at the given index. If no item
+// matches, -1 is returned.
+func (l *List[T]) FindIndex(startIndex int, count int, match Predicate[T]) (int, error) {
+	if startIndex < 0 || count < 0 || startIndex+count > len(l.items) {
+		return -1, ErrIndexOutOfRange
+	}
+
+	for i := startIndex; i < startIndex+count; i++ {
+		if match(l.items[i]) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// FindLastIndex returns the index of the last item that matches the given
+// predicate, searching count items starting at the given index. If no item
+// matches, -1 is returned.
+func (l *List[T]) FindLastIndex(startIndex int, count int, match Predicate[T]) (int, error) {
+	if startIndex < 0 || count < 0 || startIndex+count > len(l.items) {
+		return -1, ErrIndexOutOfRange
+	}
+
+	for i := startIndex + count - 1; i >= startIndex; i-- {
+		if match(l.items[i]) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// FindAll returns a new list containing all the items that match the given
+// predicate. The new list uses the same equality comparer as this list.
+func (l *List[T]) FindAll(match Predicate[T]) *List[T] {
+	var items []T
+	for _, v := range l.items {
+		if match(v) {
+			items = append(items, v)
+		}
+	}
+	return &List[T]{items: items, comparer: l.comparer}
+}
+
+// Exists returns true if any item in the list matches the given predicate.
+func (l *List[T]) Exists(match Predicate[T]) bool {
+	for _, v := range l.items {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// TrueForAll returns true if every item in the list matches the given
+// predicate. It returns true for an empty list.
+func (l *List[T]) TrueForAll(match Predicate[T]) bool {
+	for _, v := range l.items {
+		if !match(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveAll removes all the items that match the given predicate and returns
+// the number of items removed.
+func (l *List[T]) RemoveAll(match Predicate[T]) int {
+	var removed int
+	l.items, removed = removeAll(l.items, match)
+	return removed
+}
+
+// GetRange returns a new list containing count items, starting at the given
+// index. The new list uses the same equality comparer as this list.
+func (l *List[T]) GetRange(index int, count int) (*List[T], error) {
+	if index < 0 || count < 0 || index+count > len(l.items) {
+		return nil, ErrIndexOutOfRange
+	}
+
+	items := make([]T, count)
+	copy(items, l.items[index:index+count])
+	return &List[T]{items: items, comparer: l.comparer}, nil
+}
+
+// InsertRange inserts the given items at the given index.
+func (l *List[T]) InsertRange(index int, items ...T) error {
+	if index < 0 || index > len(l.items) {
+		return ErrIndexOutOfRange
+	}
+
+	l.items = insertRange(l.items, index, items)
+	return nil
+}
+
+// RemoveRange removes count items, starting at the given index.
+func (l *List[T]) RemoveRange(index int, count int) error {
+	if index < 0 || count < 0 || index+count > len(l.items) {
+		return ErrIndexOutOfRange
+	}
+
+	l.items = removeRange(l.items, index, count)
+	return nil
+}
+
+// Reverse reverses the order of the items in the list.
+func (l *List[T]) Reverse() {
+	reverse(l.items)
+}
+
+// ReverseRange reverses the order of count items, starting at the given index.
+func (l *List[T]) ReverseRange(index int, count int) error {
+	if index < 0 || count < 0 || index+count > len(l.items) {
+		return ErrIndexOutOfRange
+	}
+
+	reverse(l.items[index : index+count])
+	return nil
+}
+
+// All returns an iterator over the indexes and items in the list, from first
+// to last. Changes made to the list during iteration are visible to the
+// iterator.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(l.items); i++ {
+			if !yield(i, l.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the items in the list, from first to last.
+// Changes made to the list during iteration are visible to the iterator.
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(l.items); i++ {
+			if !yield(l.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and items in the list, from
+// last to first. Changes made to the list during iteration are visible to the
+// iterator.
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(l.items) - 1; i >= 0; i-- {
+			if i >= len(l.items) {
+				continue
+			}
+			if !yield(i, l.items[i]) {
+				return
+			}
+		}
+	}
+}
+
 func (l *List[T]) String() string {
 	return fmt.Sprintf("%v", l.items)
 }
 
+// AsReadOnly returns a read-only view of the list. The view cannot be used to
+// modify the list, but changes made to the list are visible through it.
+func (l *List[T]) AsReadOnly() ReadOnlyList[T] {
+	return &readOnlyList[T]{list: l}
+}
+
+// Freeze returns a read-only copy of the list. Changes made to the list after
+// Freeze returns are not visible through the copy.
+func (l *List[T]) Freeze() ReadOnlyList[T] {
+	items := make([]T, len(l.items))
+	copy(items, l.items)
+	return &readOnlyList[T]{list: &List[T]{items: items, comparer: l.comparer}}
+}
+
 type ConcurrentList[T any] struct {
 	items    []T
 	comparer EqualityComparer[T]
@@ -209,6 +454,22 @@ func (l *ConcurrentList[T]) RemoveAt(index int) error {
 	return nil
 }
 
+// Size returns the number of items in the list.
+func (l *ConcurrentList[T]) Size() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return len(l.items)
+}
+
+// IsEmpty returns true if the list is empty.
+func (l *ConcurrentList[T]) IsEmpty() bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return len(l.items) == 0
+}
+
 // Clear removes all items from the list.
 func (l *ConcurrentList[T]) Clear() {
 	l.mutex.Lock()
@@ -290,8 +551,323 @@ func (l *ConcurrentList[T]) Set(index int, item T) error {
 	return nil
 }
 
+// Sort sorts the items in the list using the given comparer. The sort is not
+// guaranteed to be stable.
+func (l *ConcurrentList[T]) Sort(comparer Comparer[T]) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	sort.Sort(comparerSorter[T]{items: l.items, comparer: comparer})
+}
+
+// SortStable sorts the items in the list using the given comparer, keeping
+// equal items in their original order.
+func (l *ConcurrentList[T]) SortStable(comparer Comparer[T]) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	sort.Stable(comparerSorter[T]{items: l.items, comparer: comparer})
+}
+
+// SortRange sorts count items, starting at the given index, using the given
+// comparer.
+func (l *ConcurrentList[T]) SortRange(index int, count int, comparer Comparer[T]) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if index < 0 || count < 0 || index+count > len(l.items) {
+		return ErrIndexOutOfRange
+	}
+
+	sort.Sort(comparerSorter[T]{items: l.items[index : index+count], comparer: comparer})
+	return nil
+}
+
+// BinarySearch searches the sorted list for the given item using the given
+// comparer. If the item is found, its index is returned. If the item is not
+// found, the bitwise complement of the index at which it would be inserted is
+// returned.
+func (l *ConcurrentList[T]) BinarySearch(item T, comparer Comparer[T]) int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return binarySearch(l.items, item, comparer)
+}
+
+// Find returns the first item that matches the given predicate. If no item
+// matches, the zero value and false are returned.
+func (l *ConcurrentList[T]) Find(match Predicate[T]) (T, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	for _, v := range l.items {
+		if match(v) {
+			return v, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// FindLast returns the last item that matches the given predicate. If no item
+// matches, the zero value and false are returned.
+func (l *ConcurrentList[T]) FindLast(match Predicate[T]) (T, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	for i := len(l.items) - 1; i >= 0; i-- {
+		if match(l.items[i]) {
+			return l.items[i], true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// FindIndex returns the index of the first item that matches the given
+// predicate, searching count items starting at the given index. If no item
+// matches, -1 is returned.
+func (l *ConcurrentList[T]) FindIndex(startIndex int, count int, match Predicate[T]) (int, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	if startIndex < 0 || count < 0 || startIndex+count > len(l.items) {
+		return -1, ErrIndexOutOfRange
+	}
+
+	for i := startIndex; i < startIndex+count; i++ {
+		if match(l.items[i]) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// FindLastIndex returns the index of the last item that matches the given
+// predicate, searching count items starting at the given index. If no item
+// matches, -1 is returned.
+func (l *ConcurrentList[T]) FindLastIndex(startIndex int, count int, match Predicate[T]) (int, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	if startIndex < 0 || count < 0 || startIndex+count > len(l.items) {
+		return -1, ErrIndexOutOfRange
+	}
+
+	for i := startIndex + count - 1; i >= startIndex; i-- {
+		if match(l.items[i]) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// FindAll returns a new list containing all the items that match the given
+// predicate. The new list uses the same equality comparer as this list and is
+// not thread-safe.
+func (l *ConcurrentList[T]) FindAll(match Predicate[T]) *List[T] {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	var items []T
+	for _, v := range l.items {
+		if match(v) {
+			items = append(items, v)
+		}
+	}
+	return &List[T]{items: items, comparer: l.comparer}
+}
+
+// Exists returns true if any item in the list matches the given predicate.
+func (l *ConcurrentList[T]) Exists(match Predicate[T]) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	for _, v := range l.items {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// TrueForAll returns true if every item in the list matches the given
+// predicate. It returns true for an empty list.
+func (l *ConcurrentList[T]) TrueForAll(match Predicate[T]) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	for _, v := range l.items {
+		if !match(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveAll removes all the items that match the given predicate and returns
+// the number of items removed.
+func (l *ConcurrentList[T]) RemoveAll(match Predicate[T]) int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	var removed int
+	l.items, removed = removeAll(l.items, match)
+	return removed
+}
+
+// GetRange returns a new list containing count items, starting at the given
+// index. The new list uses the same equality comparer as this list and is not
+// thread-safe.
+func (l *ConcurrentList[T]) GetRange(index int, count int) (*List[T], error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	if index < 0 || count < 0 || index+count > len(l.items) {
+		return nil, ErrIndexOutOfRange
+	}
+
+	items := make([]T, count)
+	copy(items, l.items[index:index+count])
+	return &List[T]{items: items, comparer: l.comparer}, nil
+}
+
+// InsertRange inserts the given items at the given index.
+func (l *ConcurrentList[T]) InsertRange(index int, items ...T) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if index < 0 || index > len(l.items) {
+		return ErrIndexOutOfRange
+	}
+
+	l.items = insertRange(l.items, index, items)
+	return nil
+}
+
+// RemoveRange removes count items, starting at the given index.
+func (l *ConcurrentList[T]) RemoveRange(index int, count int) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if index < 0 || count < 0 || index+count > len(l.items) {
+		return ErrIndexOutOfRange
+	}
+
+	l.items = removeRange(l.items, index, count)
+	return nil
+}
+
+// Reverse reverses the order of the items in the list.
+func (l *ConcurrentList[T]) Reverse() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	reverse(l.items)
+}
+
+// ReverseRange reverses the order of count items, starting at the given index.
+func (l *ConcurrentList[T]) ReverseRange(index int, count int) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if index < 0 || count < 0 || index+count > len(l.items) {
+		return ErrIndexOutOfRange
+	}
+
+	reverse(l.items[index : index+count])
+	return nil
+}
+
+// All returns an iterator over the indexes and items in the list, from first
+// to last. The iterator works on a snapshot of the list taken when iteration
+// starts, so the lock is not held while the loop body runs and changes made to
+// the list during iteration are not visible to the iterator.
+func (l *ConcurrentList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range l.snapshot() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the items in the list, from first to last.
+// The iterator works on a snapshot of the list taken when iteration starts.
+func (l *ConcurrentList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range l.snapshot() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indexes and items in the list, from
+// last to first. The iterator works on a snapshot of the list taken when
+// iteration starts.
+func (l *ConcurrentList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		items := l.snapshot()
+		for i := len(items) - 1; i >= 0; i-- {
+			if !yield(i, items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns a copy of the items in the list.
+func (l *ConcurrentList[T]) snapshot() []T {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	items := make([]T, len(l.items))
+	copy(items, l.items)
+	return items
+}
+
 func (l *ConcurrentList[T]) String() string {
 	l.mutex.RLock()
 	defer l.mutex.RUnlock()
 	return fmt.Sprintf("%v", l.items)
 }
+
+// AsReadOnly returns a read-only view of the list. The view cannot be used to
+// modify the list, but changes made to the list are visible through it. Like
+// the list, the view is thread-safe.
+func (l *ConcurrentList[T]) AsReadOnly() ReadOnlyList[T] {
+	return &readOnlyList[T]{list: l}
+}
+
+// Freeze returns a read-only copy of the list. Changes made to the list after
+// Freeze returns are not visible through the copy. The copy is never modified,
+// so it can be read from any number of goroutines.
+func (l *ConcurrentList[T]) Freeze() ReadOnlyList[T] {
+	return &readOnlyList[T]{list: &List[T]{items: l.snapshot(), comparer: l.comparer}}
+}
+
+// insertRange inserts the given items into the slice at the given index,
+// shifting the items after the index with a single copy. The items may share
+// the slice's backing array, such as when a list is created from a slice and
+// then given the same slice to insert.
+func insertRange[T any](items []T, index int, values []T) []T {
+	if len(values) == 0 {
+		return items
+	}
+	return slices.Insert(items, index, values...)
+}
+
+// removeRange removes count items from the slice, starting at the given index,
+// with a single copy. The vacated slots at the end of the slice are zeroed so
+// that they can be garbage collected.
+func removeRange[T any](items []T, index int, count int) []T {
+	copy(items[index:], items[index+count:])
+
+	var zero T
+	for i := len(items) - count; i < len(items); i++ {
+		items[i] = zero
+	}
+	return items[:len(items)-count]
+}
+
+// reverse reverses the order of the items in the slice.
+func reverse[T any](items []T) {
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
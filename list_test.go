@@ -1397,3 +1397,1240 @@ func ExampleList_Set() {
 	fmt.Println(list.String())
 	// Output: [1 4 3]
 }
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestList_Sort(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		l    *List[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "Normal",
+			l:    NewList[int](3, 1, 2),
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "Empty",
+			l:    NewList[int](),
+			want: nil,
+		},
+		{
+			name: "Sorted",
+			l:    NewList[int](1, 2, 3),
+			want: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.l.Sort(compareInts)
+			if !reflect.DeepEqual(tt.l.items, tt.want) {
+				t.Errorf("Sort() got = %v, want %v", tt.l.items, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_SortStable(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+	l := NewListWithEqualityComparer[pair](func(a, b pair) bool { return a == b },
+		pair{2, "a"}, pair{1, "b"}, pair{2, "c"}, pair{1, "d"})
+	l.SortStable(func(a, b pair) int { return compareInts(a.key, b.key) })
+
+	want := []pair{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}
+	if !reflect.DeepEqual(l.items, want) {
+		t.Errorf("SortStable() got = %v, want %v", l.items, want)
+	}
+}
+
+func TestList_SortRange(t *testing.T) {
+	type args struct {
+		index int
+		count int
+	}
+	type testCase[T any] struct {
+		name    string
+		l       *List[T]
+		args    args
+		want    []T
+		wantErr bool
+	}
+	tests := []testCase[int]{
+		{
+			name:    "Normal",
+			l:       NewList[int](5, 4, 3, 2, 1),
+			args:    args{index: 1, count: 3},
+			want:    []int{5, 2, 3, 4, 1},
+			wantErr: false,
+		},
+		{
+			name:    "Whole",
+			l:       NewList[int](3, 2, 1),
+			args:    args{index: 0, count: 3},
+			want:    []int{1, 2, 3},
+			wantErr: false,
+		},
+		{
+			name:    "CountOutOfRange",
+			l:       NewList[int](3, 2, 1),
+			args:    args{index: 1, count: 3},
+			want:    []int{3, 2, 1},
+			wantErr: true,
+		},
+		{
+			name:    "NegativeIndex",
+			l:       NewList[int](3, 2, 1),
+			args:    args{index: -1, count: 1},
+			want:    []int{3, 2, 1},
+			wantErr: true,
+		},
+		{
+			name:    "NegativeCount",
+			l:       NewList[int](3, 2, 1),
+			args:    args{index: 0, count: -1},
+			want:    []int{3, 2, 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.l.SortRange(tt.args.index, tt.args.count, compareInts); (err != nil) != tt.wantErr {
+				t.Errorf("SortRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.l.items, tt.want) {
+				t.Errorf("SortRange() got = %v, want %v", tt.l.items, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_BinarySearch(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		l    *List[T]
+		item T
+		want int
+	}
+	tests := []testCase[int]{
+		{
+			name: "Found",
+			l:    NewList[int](1, 3, 5, 7),
+			item: 5,
+			want: 2,
+		},
+		{
+			name: "NotFoundMiddle",
+			l:    NewList[int](1, 3, 5, 7),
+			item: 4,
+			want: ^2,
+		},
+		{
+			name: "NotFoundStart",
+			l:    NewList[int](1, 3, 5, 7),
+			item: 0,
+			want: ^0,
+		},
+		{
+			name: "NotFoundEnd",
+			l:    NewList[int](1, 3, 5, 7),
+			item: 8,
+			want: ^4,
+		},
+		{
+			name: "Empty",
+			l:    NewList[int](),
+			item: 1,
+			want: ^0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.l.BinarySearch(tt.item, compareInts); got != tt.want {
+				t.Errorf("BinarySearch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConcurrentList_Sort(t *testing.T) {
+	l := NewConcurrentList[int](3, 1, 2)
+	l.Sort(compareInts)
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("Sort() got = %v, want %v", l.items, want)
+	}
+}
+
+func TestConcurrentList_SortStable(t *testing.T) {
+	l := NewConcurrentList[string]("bb", "a", "cc", "d")
+	l.SortStable(func(a, b string) int { return compareInts(len(a), len(b)) })
+	if want := []string{"a", "d", "bb", "cc"}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("SortStable() got = %v, want %v", l.items, want)
+	}
+}
+
+func TestConcurrentList_SortRange(t *testing.T) {
+	l := NewConcurrentList[int](5, 4, 3, 2, 1)
+	if err := l.SortRange(1, 3, compareInts); err != nil {
+		t.Errorf("SortRange() error = %v", err)
+	}
+	if want := []int{5, 2, 3, 4, 1}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("SortRange() got = %v, want %v", l.items, want)
+	}
+	if err := l.SortRange(3, 3, compareInts); err != ErrIndexOutOfRange {
+		t.Errorf("SortRange() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+}
+
+func TestConcurrentList_BinarySearch(t *testing.T) {
+	l := NewConcurrentList[int](1, 3, 5, 7)
+	if got := l.BinarySearch(7, compareInts); got != 3 {
+		t.Errorf("BinarySearch() = %v, want %v", got, 3)
+	}
+	if got := l.BinarySearch(6, compareInts); got != ^3 {
+		t.Errorf("BinarySearch() = %v, want %v", got, ^3)
+	}
+}
+
+func TestConcurrentList_SortConcurrent(t *testing.T) {
+	l := NewConcurrentList[int]()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			l.Add(i)
+		}(i)
+		go func() {
+			defer wg.Done()
+			l.Sort(compareInts)
+		}()
+	}
+	wg.Wait()
+
+	l.Sort(compareInts)
+	if want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("Sort() got = %v, want %v", l.items, want)
+	}
+}
+
+func ExampleList_Sort() {
+	// Create a new List
+	list := NewList[int](3, 1, 2)
+
+	// Sort the list in ascending order
+	list.Sort(func(a, b int) int { return a - b })
+
+	// Print the contents of the list
+	fmt.Println(list.String())
+	// Output: [1 2 3]
+}
+
+func ExampleList_BinarySearch() {
+	// Create a new sorted List
+	list := NewList[int](1, 3, 5)
+	comparer := func(a, b int) int { return a - b }
+
+	// Find the position at which 4 should be inserted
+	index := list.BinarySearch(4, comparer)
+	if index < 0 {
+		_ = list.Insert(^index, 4)
+	}
+
+	// Print the contents of the list
+	fmt.Println(list.String())
+	// Output: [1 3 4 5]
+}
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func TestList_Find(t *testing.T) {
+	type testCase[T any] struct {
+		name   string
+		l      *List[T]
+		want   T
+		wantOk bool
+	}
+	tests := []testCase[int]{
+		{
+			name:   "Found",
+			l:      NewList[int](1, 2, 3, 4),
+			want:   2,
+			wantOk: true,
+		},
+		{
+			name:   "NotFound",
+			l:      NewList[int](1, 3, 5),
+			want:   0,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.l.Find(isEven)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Find() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestList_FindLast(t *testing.T) {
+	type testCase[T any] struct {
+		name   string
+		l      *List[T]
+		want   T
+		wantOk bool
+	}
+	tests := []testCase[int]{
+		{
+			name:   "Found",
+			l:      NewList[int](1, 2, 3, 4, 5),
+			want:   4,
+			wantOk: true,
+		},
+		{
+			name:   "NotFound",
+			l:      NewList[int](1, 3, 5),
+			want:   0,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.l.FindLast(isEven)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("FindLast() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestList_FindIndex(t *testing.T) {
+	type args struct {
+		startIndex int
+		count      int
+	}
+	type testCase[T any] struct {
+		name    string
+		l       *List[T]
+		args    args
+		want    int
+		wantErr bool
+	}
+	tests := []testCase[int]{
+		{
+			name:    "Whole",
+			l:       NewList[int](1, 2, 3, 4),
+			args:    args{startIndex: 0, count: 4},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "Range",
+			l:       NewList[int](1, 2, 3, 4),
+			args:    args{startIndex: 2, count: 2},
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name:    "NotFound",
+			l:       NewList[int](1, 2, 3, 4),
+			args:    args{startIndex: 2, count: 1},
+			want:    -1,
+			wantErr: false,
+		},
+		{
+			name:    "CountOutOfRange",
+			l:       NewList[int](1, 2, 3, 4),
+			args:    args{startIndex: 2, count: 3},
+			want:    -1,
+			wantErr: true,
+		},
+		{
+			name:    "NegativeIndex",
+			l:       NewList[int](1, 2, 3, 4),
+			args:    args{startIndex: -1, count: 2},
+			want:    -1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.l.FindIndex(tt.args.startIndex, tt.args.count, isEven)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindIndex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FindIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_FindLastIndex(t *testing.T) {
+	type args struct {
+		startIndex int
+		count      int
+	}
+	type testCase[T any] struct {
+		name    string
+		l       *List[T]
+		args    args
+		want    int
+		wantErr bool
+	}
+	tests := []testCase[int]{
+		{
+			name:    "Whole",
+			l:       NewList[int](1, 2, 3, 4, 5),
+			args:    args{startIndex: 0, count: 5},
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name:    "Range",
+			l:       NewList[int](1, 2, 3, 4, 5),
+			args:    args{startIndex: 0, count: 3},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "NotFound",
+			l:       NewList[int](1, 2, 3, 4, 5),
+			args:    args{startIndex: 4, count: 1},
+			want:    -1,
+			wantErr: false,
+		},
+		{
+			name:    "OutOfRange",
+			l:       NewList[int](1, 2, 3, 4, 5),
+			args:    args{startIndex: 4, count: 2},
+			want:    -1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.l.FindLastIndex(tt.args.startIndex, tt.args.count, isEven)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindLastIndex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FindLastIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_FindAll(t *testing.T) {
+	l := NewList[int](1, 2, 3, 4)
+	got := l.FindAll(isEven)
+	if want := []int{2, 4}; !reflect.DeepEqual(got.items, want) {
+		t.Errorf("FindAll() got = %v, want %v", got.items, want)
+	}
+	if got.comparer == nil {
+		t.Errorf("FindAll() returned a List with a nil comparer")
+	}
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("FindAll() modified the list: %v", l.items)
+	}
+}
+
+func TestList_Exists(t *testing.T) {
+	tests := []struct {
+		name string
+		l    *List[int]
+		want bool
+	}{
+		{name: "Exists", l: NewList[int](1, 2, 3), want: true},
+		{name: "NotExists", l: NewList[int](1, 3), want: false},
+		{name: "Empty", l: NewList[int](), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.l.Exists(isEven); got != tt.want {
+				t.Errorf("Exists() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_TrueForAll(t *testing.T) {
+	tests := []struct {
+		name string
+		l    *List[int]
+		want bool
+	}{
+		{name: "All", l: NewList[int](2, 4), want: true},
+		{name: "NotAll", l: NewList[int](2, 3), want: false},
+		{name: "Empty", l: NewList[int](), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.l.TrueForAll(isEven); got != tt.want {
+				t.Errorf("TrueForAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_RemoveAll(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		l    *List[T]
+		want []T
+		n    int
+	}
+	tests := []testCase[int]{
+		{
+			name: "Some",
+			l:    NewList[int](1, 2, 3, 4, 5, 6),
+			want: []int{1, 3, 5},
+			n:    3,
+		},
+		{
+			name: "None",
+			l:    NewList[int](1, 3),
+			want: []int{1, 3},
+			n:    0,
+		},
+		{
+			name: "All",
+			l:    NewList[int](2, 4),
+			want: []int{},
+			n:    2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backing := tt.l.items
+			if got := tt.l.RemoveAll(isEven); got != tt.n {
+				t.Errorf("RemoveAll() = %v, want %v", got, tt.n)
+			}
+			if !reflect.DeepEqual(tt.l.items, tt.want) {
+				t.Errorf("RemoveAll() got = %v, want %v", tt.l.items, tt.want)
+			}
+			for i := len(tt.want); i < len(backing); i++ {
+				if backing[i] != 0 {
+					t.Errorf("RemoveAll() did not clear slot %d: %v", i, backing)
+				}
+			}
+		})
+	}
+}
+
+func TestConcurrentList_Find(t *testing.T) {
+	l := NewConcurrentList[int](1, 2, 3, 4)
+	if got, ok := l.Find(isEven); got != 2 || !ok {
+		t.Errorf("Find() = %v, %v, want %v, %v", got, ok, 2, true)
+	}
+	if got, ok := l.FindLast(isEven); got != 4 || !ok {
+		t.Errorf("FindLast() = %v, %v, want %v, %v", got, ok, 4, true)
+	}
+	if got, err := l.FindIndex(2, 2, isEven); got != 3 || err != nil {
+		t.Errorf("FindIndex() = %v, %v, want %v, %v", got, err, 3, nil)
+	}
+	if got, err := l.FindLastIndex(0, 3, isEven); got != 1 || err != nil {
+		t.Errorf("FindLastIndex() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if _, err := l.FindIndex(3, 2, isEven); err != ErrIndexOutOfRange {
+		t.Errorf("FindIndex() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if got := l.FindAll(isEven); !reflect.DeepEqual(got.items, []int{2, 4}) {
+		t.Errorf("FindAll() = %v, want %v", got, []int{2, 4})
+	}
+	if !l.Exists(isEven) {
+		t.Errorf("Exists() = false, want true")
+	}
+	if l.TrueForAll(isEven) {
+		t.Errorf("TrueForAll() = true, want false")
+	}
+}
+
+func TestConcurrentList_RemoveAll(t *testing.T) {
+	l := NewConcurrentList[int]()
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			l.Add(i)
+			l.RemoveAll(isEven)
+		}(i)
+	}
+	wg.Wait()
+
+	if !l.TrueForAll(func(v int) bool { return !isEven(v) }) {
+		t.Errorf("RemoveAll() left even items: %v", l)
+	}
+	if got := len(l.items); got != 50 {
+		t.Errorf("RemoveAll() left %d items, want %d", got, 50)
+	}
+}
+
+func ExampleList_FindAll() {
+	// Create a new List
+	list := NewList[int](1, 2, 3, 4, 5, 6)
+
+	// Find all the even numbers
+	even := list.FindAll(func(v int) bool { return v%2 == 0 })
+
+	// Print the contents of the new list
+	fmt.Println(even.String())
+	// Output: [2 4 6]
+}
+
+func ExampleList_RemoveAll() {
+	// Create a new List
+	list := NewList[int](1, 2, 3, 4, 5, 6)
+
+	// Remove all the even numbers
+	removed := list.RemoveAll(func(v int) bool { return v%2 == 0 })
+
+	// Print the number of items removed and the contents of the list
+	fmt.Println(removed, list.String())
+	// Output: 3 [1 3 5]
+}
+
+func TestList_GetRange(t *testing.T) {
+	type args struct {
+		index int
+		count int
+	}
+	type testCase[T any] struct {
+		name    string
+		l       *List[T]
+		args    args
+		want    []T
+		wantErr bool
+	}
+	tests := []testCase[string]{
+		{
+			name:    "Normal",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: 1, count: 2},
+			want:    []string{"2", "3"},
+			wantErr: false,
+		},
+		{
+			name:    "Empty",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: 4, count: 0},
+			want:    []string{},
+			wantErr: false,
+		},
+		{
+			name:    "CountOutOfRange",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: 3, count: 2},
+			wantErr: true,
+		},
+		{
+			name:    "NegativeIndex",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: -1, count: 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.l.GetRange(tt.args.index, tt.args.count)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.items, tt.want) {
+				t.Errorf("GetRange() got = %v, want %v", got.items, tt.want)
+			}
+			if len(got.items) > 0 {
+				got.items[0] = "changed"
+				if tt.l.items[tt.args.index] == "changed" {
+					t.Errorf("GetRange() returned a list that shares items with the original")
+				}
+			}
+		})
+	}
+}
+
+func TestList_InsertRange(t *testing.T) {
+	type args[T any] struct {
+		index int
+		items []T
+	}
+	type testCase[T any] struct {
+		name    string
+		l       *List[T]
+		args    args[T]
+		want    []T
+		wantErr bool
+	}
+	tests := []testCase[string]{
+		{
+			name:    "Middle",
+			l:       NewList[string]("1", "2", "3"),
+			args:    args[string]{index: 1, items: []string{"4", "5"}},
+			want:    []string{"1", "4", "5", "2", "3"},
+			wantErr: false,
+		},
+		{
+			name:    "Start",
+			l:       NewList[string]("1", "2", "3"),
+			args:    args[string]{index: 0, items: []string{"4", "5"}},
+			want:    []string{"4", "5", "1", "2", "3"},
+			wantErr: false,
+		},
+		{
+			name:    "End",
+			l:       NewList[string]("1", "2", "3"),
+			args:    args[string]{index: 3, items: []string{"4", "5"}},
+			want:    []string{"1", "2", "3", "4", "5"},
+			wantErr: false,
+		},
+		{
+			name:    "NoItems",
+			l:       NewList[string]("1", "2", "3"),
+			args:    args[string]{index: 1, items: nil},
+			want:    []string{"1", "2", "3"},
+			wantErr: false,
+		},
+		{
+			name:    "IndexOutOfRange",
+			l:       NewList[string]("1", "2", "3"),
+			args:    args[string]{index: 4, items: []string{"4"}},
+			want:    []string{"1", "2", "3"},
+			wantErr: true,
+		},
+		{
+			name:    "NegativeIndex",
+			l:       NewList[string]("1", "2", "3"),
+			args:    args[string]{index: -1, items: []string{"4"}},
+			want:    []string{"1", "2", "3"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.l.InsertRange(tt.args.index, tt.args.items...); (err != nil) != tt.wantErr {
+				t.Errorf("InsertRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.l.items, tt.want) {
+				t.Errorf("InsertRange() got = %v, want %v", tt.l.items, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_InsertRange_Aliased(t *testing.T) {
+	// The list shares s's backing array, and the items to insert run past
+	// the end of the list into its spare capacity.
+	s := append(make([]int, 0, 8), 1, 2, 3, 4)
+	l := NewList(s[:2]...)
+	if err := l.InsertRange(1, s...); err != nil {
+		t.Fatalf("InsertRange() error = %v", err)
+	}
+	want := []int{1, 1, 2, 3, 4, 2}
+	if !reflect.DeepEqual(l.items, want) {
+		t.Errorf("InsertRange() got = %v, want %v", l.items, want)
+	}
+}
+
+func TestList_RemoveRange(t *testing.T) {
+	type args struct {
+		index int
+		count int
+	}
+	type testCase[T any] struct {
+		name    string
+		l       *List[T]
+		args    args
+		want    []T
+		wantErr bool
+	}
+	tests := []testCase[string]{
+		{
+			name:    "Middle",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: 1, count: 2},
+			want:    []string{"1", "4"},
+			wantErr: false,
+		},
+		{
+			name:    "All",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: 0, count: 4},
+			want:    []string{},
+			wantErr: false,
+		},
+		{
+			name:    "None",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: 2, count: 0},
+			want:    []string{"1", "2", "3", "4"},
+			wantErr: false,
+		},
+		{
+			name:    "CountOutOfRange",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: 3, count: 2},
+			want:    []string{"1", "2", "3", "4"},
+			wantErr: true,
+		},
+		{
+			name:    "NegativeCount",
+			l:       NewList[string]("1", "2", "3", "4"),
+			args:    args{index: 0, count: -1},
+			want:    []string{"1", "2", "3", "4"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backing := tt.l.items
+			if err := tt.l.RemoveRange(tt.args.index, tt.args.count); (err != nil) != tt.wantErr {
+				t.Errorf("RemoveRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.l.items, tt.want) {
+				t.Errorf("RemoveRange() got = %v, want %v", tt.l.items, tt.want)
+			}
+			for i := len(tt.want); i < len(backing); i++ {
+				if backing[i] != "" {
+					t.Errorf("RemoveRange() did not clear slot %d: %v", i, backing)
+				}
+			}
+		})
+	}
+}
+
+func TestList_Reverse(t *testing.T) {
+	tests := []struct {
+		name string
+		l    *List[int]
+		want []int
+	}{
+		{name: "Odd", l: NewList[int](1, 2, 3), want: []int{3, 2, 1}},
+		{name: "Even", l: NewList[int](1, 2, 3, 4), want: []int{4, 3, 2, 1}},
+		{name: "Empty", l: NewList[int](), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.l.Reverse()
+			if !reflect.DeepEqual(tt.l.items, tt.want) {
+				t.Errorf("Reverse() got = %v, want %v", tt.l.items, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_ReverseRange(t *testing.T) {
+	type args struct {
+		index int
+		count int
+	}
+	tests := []struct {
+		name    string
+		l       *List[int]
+		args    args
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:    "Normal",
+			l:       NewList[int](1, 2, 3, 4, 5),
+			args:    args{index: 1, count: 3},
+			want:    []int{1, 4, 3, 2, 5},
+			wantErr: false,
+		},
+		{
+			name:    "OutOfRange",
+			l:       NewList[int](1, 2, 3, 4, 5),
+			args:    args{index: 3, count: 3},
+			want:    []int{1, 2, 3, 4, 5},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.l.ReverseRange(tt.args.index, tt.args.count); (err != nil) != tt.wantErr {
+				t.Errorf("ReverseRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.l.items, tt.want) {
+				t.Errorf("ReverseRange() got = %v, want %v", tt.l.items, tt.want)
+			}
+		})
+	}
+}
+
+func TestConcurrentList_Ranges(t *testing.T) {
+	l := NewConcurrentList[int](1, 2, 3)
+
+	if err := l.InsertRange(1, 4, 5); err != nil {
+		t.Errorf("InsertRange() error = %v", err)
+	}
+	if want := []int{1, 4, 5, 2, 3}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("InsertRange() got = %v, want %v", l.items, want)
+	}
+
+	got, err := l.GetRange(1, 2)
+	if err != nil {
+		t.Errorf("GetRange() error = %v", err)
+	} else if want := []int{4, 5}; !reflect.DeepEqual(got.items, want) {
+		t.Errorf("GetRange() got = %v, want %v", got.items, want)
+	}
+
+	l.Reverse()
+	if want := []int{3, 2, 5, 4, 1}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("Reverse() got = %v, want %v", l.items, want)
+	}
+
+	if err := l.ReverseRange(0, 2); err != nil {
+		t.Errorf("ReverseRange() error = %v", err)
+	}
+	if want := []int{2, 3, 5, 4, 1}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("ReverseRange() got = %v, want %v", l.items, want)
+	}
+
+	if err := l.RemoveRange(1, 3); err != nil {
+		t.Errorf("RemoveRange() error = %v", err)
+	}
+	if want := []int{2, 1}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("RemoveRange() got = %v, want %v", l.items, want)
+	}
+
+	if err := l.InsertRange(3, 1); err != ErrIndexOutOfRange {
+		t.Errorf("InsertRange() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if err := l.RemoveRange(1, 2); err != ErrIndexOutOfRange {
+		t.Errorf("RemoveRange() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if _, err := l.GetRange(-1, 1); err != ErrIndexOutOfRange {
+		t.Errorf("GetRange() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if err := l.ReverseRange(0, 3); err != ErrIndexOutOfRange {
+		t.Errorf("ReverseRange() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+}
+
+func ExampleList_InsertRange() {
+	// Create a new List
+	list := NewList[int](1, 5)
+
+	// Insert several items at index 1
+	_ = list.InsertRange(1, 2, 3, 4)
+
+	// Print the contents of the list
+	fmt.Println(list.String())
+	// Output: [1 2 3 4 5]
+}
+
+func ExampleList_RemoveRange() {
+	// Create a new List
+	list := NewList[int](1, 2, 3, 4, 5)
+
+	// Remove three items starting at index 1
+	_ = list.RemoveRange(1, 3)
+
+	// Print the contents of the list
+	fmt.Println(list.String())
+	// Output: [1 5]
+}
+
+func ExampleList_Reverse() {
+	// Create a new List
+	list := NewList[int](1, 2, 3)
+
+	// Reverse the list
+	list.Reverse()
+
+	// Print the contents of the list
+	fmt.Println(list.String())
+	// Output: [3 2 1]
+}
+
+func TestList_All(t *testing.T) {
+	l := NewList[string]("a", "b", "c")
+
+	var indexes []int
+	var items []string
+	for i, v := range l.All() {
+		indexes = append(indexes, i)
+		items = append(items, v)
+	}
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(indexes, want) {
+		t.Errorf("All() indexes = %v, want %v", indexes, want)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(items, want) {
+		t.Errorf("All() items = %v, want %v", items, want)
+	}
+}
+
+func TestList_Values(t *testing.T) {
+	tests := []struct {
+		name  string
+		l     *List[int]
+		limit int
+		want  []int
+	}{
+		{name: "All", l: NewList[int](1, 2, 3), limit: 10, want: []int{1, 2, 3}},
+		{name: "Break", l: NewList[int](1, 2, 3), limit: 2, want: []int{1, 2}},
+		{name: "Empty", l: NewList[int](), limit: 10, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for v := range tt.l.Values() {
+				got = append(got, v)
+				if len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Values() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_Backward(t *testing.T) {
+	l := NewList[string]("a", "b", "c")
+
+	var indexes []int
+	var items []string
+	for i, v := range l.Backward() {
+		indexes = append(indexes, i)
+		items = append(items, v)
+	}
+	if want := []int{2, 1, 0}; !reflect.DeepEqual(indexes, want) {
+		t.Errorf("Backward() indexes = %v, want %v", indexes, want)
+	}
+	if want := []string{"c", "b", "a"}; !reflect.DeepEqual(items, want) {
+		t.Errorf("Backward() items = %v, want %v", items, want)
+	}
+}
+
+func TestList_BackwardWhileRemoving(t *testing.T) {
+	l := NewList[int](1, 2, 3, 4)
+	for i, v := range l.Backward() {
+		if isEven(v) {
+			_ = l.RemoveAt(i)
+		}
+	}
+	if want := []int{1, 3}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("Backward() got = %v, want %v", l.items, want)
+	}
+}
+
+func TestConcurrentList_All(t *testing.T) {
+	l := NewConcurrentList[int](1, 2, 3)
+
+	var got []int
+	for i, v := range l.All() {
+		// The iterator works on a snapshot, so mutating the list must neither
+		// deadlock nor change what is being iterated.
+		l.Add(v * 10)
+		got = append(got, i, v)
+	}
+	if want := []int{0, 1, 1, 2, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	if want := []int{1, 2, 3, 10, 20, 30}; !reflect.DeepEqual(l.items, want) {
+		t.Errorf("All() list = %v, want %v", l.items, want)
+	}
+}
+
+func TestConcurrentList_Values(t *testing.T) {
+	l := NewConcurrentList[int](1, 2, 3)
+
+	var got []int
+	for v := range l.Values() {
+		l.Clear()
+		got = append(got, v)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestConcurrentList_Backward(t *testing.T) {
+	l := NewConcurrentList[int](1, 2, 3)
+
+	var got []int
+	for i, v := range l.Backward() {
+		got = append(got, i, v)
+		if i == 1 {
+			break
+		}
+	}
+	if want := []int{2, 3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Backward() = %v, want %v", got, want)
+	}
+}
+
+func ExampleList_All() {
+	// Create a new List
+	list := NewList[string]("a", "b", "c")
+
+	// Iterate over the indexes and items in the list
+	for i, v := range list.All() {
+		fmt.Println(i, v)
+	}
+	// Output:
+	// 0 a
+	// 1 b
+	// 2 c
+}
+
+func ExampleList_Backward() {
+	// Create a new List
+	list := NewList[string]("a", "b", "c")
+
+	// Iterate over the list from last to first
+	for i, v := range list.Backward() {
+		fmt.Println(i, v)
+	}
+	// Output:
+	// 2 c
+	// 1 b
+	// 0 a
+}
+
+func TestList_Size(t *testing.T) {
+	l := NewList[int]()
+	if got := l.Size(); got != 0 || !l.IsEmpty() {
+		t.Errorf("Size() = %v, IsEmpty() = %v, want %v, %v", got, l.IsEmpty(), 0, true)
+	}
+	l.AddRange(1, 2, 3)
+	if got := l.Size(); got != 3 || l.IsEmpty() {
+		t.Errorf("Size() = %v, IsEmpty() = %v, want %v, %v", got, l.IsEmpty(), 3, false)
+	}
+}
+
+func TestList_AsReadOnly(t *testing.T) {
+	l := NewList[string]("a", "b")
+	r := l.AsReadOnly()
+
+	if _, ok := r.(IList[string]); ok {
+		t.Errorf("AsReadOnly() returned a view that can modify the list")
+	}
+
+	// Changes to the list are visible through the view.
+	l.Add("c")
+	if got := r.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+	if got, err := r.Get(2); got != "c" || err != nil {
+		t.Errorf("Get(2) = %v, %v, want %v, %v", got, err, "c", nil)
+	}
+	if _, err := r.Get(3); err != ErrIndexOutOfRange {
+		t.Errorf("Get(3) error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if got := r.IndexOf("b"); got != 1 {
+		t.Errorf("IndexOf() = %v, want %v", got, 1)
+	}
+	if !r.Contains("a") || r.Contains("d") {
+		t.Errorf("Contains() does not match the list")
+	}
+	if got := r.String(); got != "[a b c]" {
+		t.Errorf("String() = %v, want %v", got, "[a b c]")
+	}
+
+	var got []string
+	for v := range r.Values() {
+		got = append(got, v)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+
+	items := make([]string, 3)
+	if err := r.CopyTo(items, 0); err != nil || !reflect.DeepEqual(items, got) {
+		t.Errorf("CopyTo() = %v, %v, want %v, %v", items, err, got, nil)
+	}
+}
+
+func TestList_Freeze(t *testing.T) {
+	l := NewList[string]("a", "b")
+	r := l.Freeze()
+
+	// Changes to the list are not visible through the snapshot.
+	l.Add("c")
+	_ = l.Set(0, "z")
+	if got := r.Size(); got != 2 {
+		t.Errorf("Size() = %v, want %v", got, 2)
+	}
+	if got := r.String(); got != "[a b]" {
+		t.Errorf("String() = %v, want %v", got, "[a b]")
+	}
+	if !r.Contains("a") || r.Contains("z") {
+		t.Errorf("Contains() does not match the snapshot")
+	}
+	for i, v := range r.All() {
+		if want := []string{"a", "b"}[i]; v != want {
+			t.Errorf("All() item %v = %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestConcurrentList_AsReadOnly(t *testing.T) {
+	l := NewConcurrentList[int](1, 2, 3)
+	r := l.AsReadOnly()
+	frozen := l.Freeze()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 4; i <= 100; i++ {
+			l.Add(i)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		_ = r.Contains(50)
+		_ = frozen.Contains(50)
+	}
+	wg.Wait()
+
+	if got := r.Size(); got != 100 {
+		t.Errorf("AsReadOnly().Size() = %v, want %v", got, 100)
+	}
+	if got := frozen.Size(); got != 3 {
+		t.Errorf("Freeze().Size() = %v, want %v", got, 3)
+	}
+	if got, err := frozen.Get(2); got != 3 || err != nil {
+		t.Errorf("Freeze().Get(2) = %v, %v, want %v, %v", got, err, 3, nil)
+	}
+}
+
+func ExampleList_AsReadOnly() {
+	list := NewList[string]("a", "b")
+	view := list.AsReadOnly()
+	snapshot := list.Freeze()
+
+	list.Add("c")
+
+	fmt.Println(view)
+	fmt.Println(snapshot)
+	// Output:
+	// [a b c]
+	// [a b]
+}
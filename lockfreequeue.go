@@ -0,0 +1,412 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+	"sync/atomic"
+)
+
+// lockFreeQueueNode is a node in the linked list that backs a LockFreeQueue.
+// The value is never modified once the node has been linked into the list.
+type lockFreeQueueNode[T any] struct {
+	value T
+	next  atomic.Pointer[lockFreeQueueNode[T]]
+}
+
+// LockFreeQueue implements an unbounded FIFO data structure using the
+// Michael-Scott algorithm. Any number of goroutines can enqueue and dequeue
+// concurrently; they update the linked list with compare-and-swap instead of
+// taking a lock. It is thread-safe.
+//
+// Each Enqueue allocates a node. The most recently dequeued item stays
+// reachable until the next Dequeue, because its node becomes the sentinel at
+// the head of the list.
+//
+// LockFreeQueue and ConcurrentQueue both implement IConcurrentQueue, so the
+// two can be used interchangeably.
+//
+// A LockFreeQueue must be created with NewLockFreeQueue or
+// NewLockFreeQueueWithEqualityComparer.
+type LockFreeQueue[T any] struct {
+	head     atomic.Pointer[lockFreeQueueNode[T]]
+	tail     atomic.Pointer[lockFreeQueueNode[T]]
+	size     atomic.Int64
+	comparer EqualityComparer[T]
+}
+
+// NewLockFreeQueue returns a new queue with the given initial items.
+func NewLockFreeQueue[T comparable](values ...T) *LockFreeQueue[T] {
+	return NewLockFreeQueueWithEqualityComparer(DefaultEqualityComparer[T], values...)
+}
+
+// NewLockFreeQueueWithEqualityComparer returns a new queue with the given
+// initial items that uses the given comparer to find items.
+func NewLockFreeQueueWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{comparer: comparer}
+	sentinel := &lockFreeQueueNode[T]{}
+	q.head.Store(sentinel)
+	q.tail.Store(sentinel)
+	for _, v := range values {
+		_ = q.Enqueue(v)
+	}
+	return q
+}
+
+// Enqueue adds an item to the end of the queue. The error is always nil; it is
+// returned so that LockFreeQueue implements IConcurrentQueue.
+func (q *LockFreeQueue[T]) Enqueue(item T) error {
+	n := &lockFreeQueueNode[T]{value: item}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// The tail is lagging behind; help move it forward.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, n) {
+			q.tail.CompareAndSwap(tail, n)
+			q.size.Add(1)
+			return nil
+		}
+	}
+}
+
+// Dequeue removes and returns the item at the front of the queue. If the queue
+// is empty, an error is returned.
+func (q *LockFreeQueue[T]) Dequeue() (T, error) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, ErrEmptyQueue
+		}
+		if head == tail {
+			// The tail is lagging behind; help move it forward.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			return next.value, nil
+		}
+	}
+}
+
+// Peek returns the item at the front of the queue without removing it. If the
+// queue is empty, an error is returned.
+func (q *LockFreeQueue[T]) Peek() (T, error) {
+	next := q.head.Load().next.Load()
+	if next == nil {
+		var zero T
+		return zero, ErrEmptyQueue
+	}
+	return next.value, nil
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+// Size returns the number of items in the queue. The count is updated after
+// each enqueue or dequeue takes effect, so while other goroutines are using
+// the queue the result is only an approximation.
+func (q *LockFreeQueue[T]) Size() int {
+	n := q.size.Load()
+	if n < 0 {
+		return 0
+	}
+	return int(n)
+}
+
+// Clear removes all items from the queue. Items enqueued by other goroutines
+// while Clear runs may or may not be removed.
+func (q *LockFreeQueue[T]) Clear() {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		if next := tail.next.Load(); next != nil {
+			// The tail is lagging behind; help move it forward.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// Make the last node the sentinel, which drops every node before it.
+		n := 0
+		for node := head; node != tail; node = node.next.Load() {
+			n++
+		}
+		if q.head.CompareAndSwap(head, tail) {
+			q.size.Add(int64(-n))
+			return
+		}
+	}
+}
+
+// Contains returns true if the queue contains the given item. It searches a
+// snapshot of the queue, like Values.
+func (q *LockFreeQueue[T]) Contains(item T) bool {
+	for v := range q.Values() {
+		if q.comparer(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// CopyTo copies the items in the queue, from front to back, to the given
+// slice, starting at the given index. It copies a snapshot of the queue, like
+// Values. If the index is out of range, an error is returned. If the slice is
+// not large enough to hold all the items, an error is returned.
+func (q *LockFreeQueue[T]) CopyTo(items []T, index int) error {
+	return copySnapshot(q.Values(), items, index)
+}
+
+// Values returns an iterator over the items in the queue, from front to back,
+// without removing them. The iterator stops at the item that was at the back
+// of the queue when iteration started. Items dequeued by other goroutines
+// during iteration may still be returned.
+func (q *LockFreeQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// The tail is always reachable from a head loaded before it.
+		head := q.head.Load()
+		tail := q.tail.Load()
+		for node := head; node != tail; {
+			node = node.next.Load()
+			if node == nil || !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// boundedLockFreeSlot is a cell in the array that backs a
+// BoundedLockFreeQueue. The sequence number tells producers and consumers
+// whether the cell is ready to be written or read for a given position.
+type boundedLockFreeSlot[T any] struct {
+	sequence atomic.Uint64
+	value    atomic.Pointer[T]
+}
+
+// cacheLinePad keeps frequently written fields on separate cache lines.
+type cacheLinePad [64]byte
+
+// BoundedLockFreeQueue implements a bounded FIFO data structure using Dmitry
+// Vyukov's array-based algorithm. Any number of goroutines can enqueue and
+// dequeue concurrently; each operation claims a position with a single
+// compare-and-swap. It is thread-safe.
+//
+// The capacity is rounded up to a power of two. Items are boxed so that Peek
+// can read the front of the queue while other goroutines are dequeuing.
+//
+// A BoundedLockFreeQueue must be created with NewBoundedLockFreeQueue or
+// NewBoundedLockFreeQueueWithEqualityComparer.
+type BoundedLockFreeQueue[T any] struct {
+	slots      []boundedLockFreeSlot[T]
+	mask       uint64
+	comparer   EqualityComparer[T]
+	_          cacheLinePad
+	enqueuePos atomic.Uint64
+	_          cacheLinePad
+	dequeuePos atomic.Uint64
+	_          cacheLinePad
+}
+
+// NewBoundedLockFreeQueue returns a new, empty queue that holds at least
+// capacity items. It panics if capacity is not positive.
+func NewBoundedLockFreeQueue[T comparable](capacity int) *BoundedLockFreeQueue[T] {
+	return NewBoundedLockFreeQueueWithEqualityComparer(capacity, DefaultEqualityComparer[T])
+}
+
+// NewBoundedLockFreeQueueWithEqualityComparer returns a new, empty queue that
+// holds at least capacity items and uses the given comparer to find items. It
+// panics if capacity is not positive.
+func NewBoundedLockFreeQueueWithEqualityComparer[T any](capacity int, comparer EqualityComparer[T]) *BoundedLockFreeQueue[T] {
+	if capacity <= 0 {
+		panic("collections: capacity of a bounded queue must be positive")
+	}
+
+	size := 1
+	for size < capacity {
+		size <<= 1
+	}
+
+	q := &BoundedLockFreeQueue[T]{slots: make([]boundedLockFreeSlot[T], size), mask: uint64(size - 1), comparer: comparer}
+	for i := range q.slots {
+		q.slots[i].sequence.Store(uint64(i))
+	}
+	return q
+}
+
+// Capacity returns the maximum number of items the queue can hold.
+func (q *BoundedLockFreeQueue[T]) Capacity() int {
+	return len(q.slots)
+}
+
+// Enqueue adds an item to the end of the queue. If the queue is full,
+// ErrQueueFull is returned.
+func (q *BoundedLockFreeQueue[T]) Enqueue(item T) error {
+	pos := q.enqueuePos.Load()
+	for {
+		slot := &q.slots[pos&q.mask]
+		diff := int64(slot.sequence.Load() - pos)
+		switch {
+		case diff == 0:
+			if q.enqueuePos.CompareAndSwap(pos, pos+1) {
+				slot.value.Store(&item)
+				slot.sequence.Store(pos + 1)
+				return nil
+			}
+			pos = q.enqueuePos.Load()
+		case diff < 0:
+			return ErrQueueFull
+		default:
+			pos = q.enqueuePos.Load()
+		}
+	}
+}
+
+// Dequeue removes and returns the item at the front of the queue. If the queue
+// is empty, an error is returned.
+func (q *BoundedLockFreeQueue[T]) Dequeue() (T, error) {
+	pos := q.dequeuePos.Load()
+	for {
+		slot := &q.slots[pos&q.mask]
+		diff := int64(slot.sequence.Load() - (pos + 1))
+		switch {
+		case diff == 0:
+			if q.dequeuePos.CompareAndSwap(pos, pos+1) {
+				item := slot.value.Swap(nil)
+				slot.sequence.Store(pos + q.mask + 1)
+				return *item, nil
+			}
+			pos = q.dequeuePos.Load()
+		case diff < 0:
+			var zero T
+			return zero, ErrEmptyQueue
+		default:
+			pos = q.dequeuePos.Load()
+		}
+	}
+}
+
+// Peek returns the item at the front of the queue without removing it. If the
+// queue is empty, an error is returned.
+func (q *BoundedLockFreeQueue[T]) Peek() (T, error) {
+	for {
+		pos := q.dequeuePos.Load()
+		slot := &q.slots[pos&q.mask]
+		diff := int64(slot.sequence.Load() - (pos + 1))
+		if diff < 0 {
+			var zero T
+			return zero, ErrEmptyQueue
+		}
+		if diff == 0 {
+			item := slot.value.Load()
+			if item != nil && q.dequeuePos.Load() == pos {
+				return *item, nil
+			}
+		}
+		// A consumer took the item first; try again with the new front.
+	}
+}
+
+// IsEmpty returns true if the queue is empty. While other goroutines are using
+// the queue, the result is only an approximation.
+func (q *BoundedLockFreeQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Size returns the number of items in the queue. While other goroutines are
+// using the queue, the result is only an approximation.
+func (q *BoundedLockFreeQueue[T]) Size() int {
+	dequeued := q.dequeuePos.Load()
+	enqueued := q.enqueuePos.Load()
+	n := int64(enqueued - dequeued)
+	if n < 0 {
+		return 0
+	}
+	if n > int64(len(q.slots)) {
+		return len(q.slots)
+	}
+	return int(n)
+}
+
+// Clear removes all items from the queue. Items enqueued by other goroutines
+// while Clear runs may or may not be removed.
+func (q *BoundedLockFreeQueue[T]) Clear() {
+	for n := q.Size(); n > 0; n-- {
+		if _, err := q.Dequeue(); err != nil {
+			return
+		}
+	}
+}
+
+// Contains returns true if the queue contains the given item. It searches a
+// snapshot of the queue, like Values.
+func (q *BoundedLockFreeQueue[T]) Contains(item T) bool {
+	for v := range q.Values() {
+		if q.comparer(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// CopyTo copies the items in the queue, from front to back, to the given
+// slice, starting at the given index. It copies a snapshot of the queue, like
+// Values. If the index is out of range, an error is returned. If the slice is
+// not large enough to hold all the items, an error is returned.
+func (q *BoundedLockFreeQueue[T]) CopyTo(items []T, index int) error {
+	return copySnapshot(q.Values(), items, index)
+}
+
+// Values returns an iterator over the items in the queue, from front to back,
+// without removing them. The iterator visits the positions that were in the
+// queue when iteration started, and skips those that other goroutines dequeue
+// during iteration.
+func (q *BoundedLockFreeQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		start := q.dequeuePos.Load()
+		end := q.enqueuePos.Load()
+		for pos := start; pos != end; pos++ {
+			slot := &q.slots[pos&q.mask]
+			if slot.sequence.Load() != pos+1 {
+				continue
+			}
+			item := slot.value.Load()
+			// Make sure the slot was not dequeued and reused while reading it.
+			if item == nil || slot.sequence.Load() != pos+1 {
+				continue
+			}
+			if !yield(*item) {
+				return
+			}
+		}
+	}
+}
+
+// copySnapshot copies the items in a snapshot of a lock-free collection to
+// dst, starting at the given index.
+func copySnapshot[T any](items iter.Seq[T], dst []T, index int) error {
+	if index < 0 || index > len(dst) {
+		return ErrIndexOutOfRange
+	}
+
+	snapshot := slices.Collect(items)
+	if len(dst)-index < len(snapshot) {
+		return ErrIndexOutOfRange
+	}
+
+	copy(dst[index:], snapshot)
+	return nil
+}
@@ -0,0 +1,392 @@
+package collections
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// fifoQueue is the subset of the queue API exercised by the stress tests.
+type fifoQueue interface {
+	Enqueue(item int) error
+	Dequeue() (int, error)
+	Peek() (int, error)
+	Size() int
+}
+
+// stressFIFO runs producers and consumers against the queue and checks the
+// properties a linearizable FIFO queue must have: every item is dequeued
+// exactly once, and each consumer sees the items of any one producer in the
+// order they were enqueued.
+func stressFIFO(t *testing.T, q fifoQueue, producers, consumers, perProducer int) {
+	t.Helper()
+
+	total := producers * perProducer
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				for q.Enqueue(p*perProducer+i) != nil {
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+
+	var mutex sync.Mutex
+	var remaining = total
+	seen := make([]bool, total)
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			last := make([]int, producers)
+			for i := range last {
+				last[i] = -1
+			}
+			for {
+				mutex.Lock()
+				done := remaining == 0
+				mutex.Unlock()
+				if done {
+					return
+				}
+
+				item, err := q.Dequeue()
+				if err != nil {
+					runtime.Gosched()
+					continue
+				}
+
+				p, i := item/perProducer, item%perProducer
+				if i <= last[p] {
+					t.Errorf("dequeued item %v of producer %v after item %v", i, p, last[p])
+					return
+				}
+				last[p] = i
+
+				mutex.Lock()
+				if seen[item] {
+					mutex.Unlock()
+					t.Errorf("item %v dequeued twice", item)
+					return
+				}
+				seen[item] = true
+				remaining--
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if remaining != 0 {
+		t.Errorf("%v items were never dequeued", remaining)
+	}
+	if _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	if got := q.Size(); got != 0 {
+		t.Errorf("Size() = %v, want %v", got, 0)
+	}
+}
+
+// stressPeek runs producers against a single consumer that peeks before each
+// dequeue. Because nobody else dequeues, the item returned by Peek must be the
+// item returned by the following Dequeue.
+func stressPeek(t *testing.T, q fifoQueue, producers, perProducer int) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				for q.Enqueue(p*perProducer+i) != nil {
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+
+	for n := 0; n < producers*perProducer; {
+		peeked, err := q.Peek()
+		if err != nil {
+			runtime.Gosched()
+			continue
+		}
+		item, err := q.Dequeue()
+		if err != nil {
+			t.Fatalf("Dequeue() error = %v after Peek() returned %v", err, peeked)
+		}
+		if item != peeked {
+			t.Fatalf("Dequeue() = %v, want %v returned by Peek()", item, peeked)
+		}
+		n++
+	}
+	wg.Wait()
+}
+
+func TestNewLockFreeQueue(t *testing.T) {
+	q := NewLockFreeQueue[int](1, 2, 3)
+	if got := q.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+	for _, want := range []int{1, 2, 3} {
+		if got, err := q.Dequeue(); got != want || err != nil {
+			t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, want, nil)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() = false, want true")
+	}
+}
+
+func TestLockFreeQueue_Dequeue(t *testing.T) {
+	type testCase[T any] struct {
+		name    string
+		q       *LockFreeQueue[T]
+		want    T
+		wantErr bool
+	}
+	tests := []testCase[string]{
+		{
+			name:    "Empty",
+			q:       NewLockFreeQueue[string](),
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "NotEmpty",
+			q:       NewLockFreeQueue[string]("1", "2", "3"),
+			want:    "1",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.q.Dequeue()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Dequeue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Dequeue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockFreeQueue_Peek(t *testing.T) {
+	q := NewLockFreeQueue[string]()
+	if _, err := q.Peek(); err != ErrEmptyQueue {
+		t.Errorf("Peek() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	_ = q.Enqueue("1")
+	_ = q.Enqueue("2")
+	if got, err := q.Peek(); got != "1" || err != nil {
+		t.Errorf("Peek() = %v, %v, want %v, %v", got, err, "1", nil)
+	}
+	if got := q.Size(); got != 2 {
+		t.Errorf("Peek() removed the item")
+	}
+}
+
+func TestLockFreeQueue_Stress(t *testing.T) {
+	stressFIFO(t, NewLockFreeQueue[int](), 4, 4, 2000)
+}
+
+func TestLockFreeQueue_StressPeek(t *testing.T) {
+	stressPeek(t, NewLockFreeQueue[int](), 4, 2000)
+}
+
+func TestLockFreeQueue_Clear(t *testing.T) {
+	q := NewLockFreeQueue[int](1, 2, 3)
+	q.Clear()
+	if got := q.Size(); got != 0 {
+		t.Errorf("Size() = %v, want %v", got, 0)
+	}
+	if _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	_ = q.Enqueue(4)
+	if got, err := q.Dequeue(); got != 4 || err != nil {
+		t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, 4, nil)
+	}
+}
+
+func TestLockFreeQueue_ValuesConcurrent(t *testing.T) {
+	q := NewLockFreeQueue[int]()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				q.Contains(-1)
+			}
+		}
+	}()
+	stressFIFO(t, q, 2, 2, 1000)
+	close(stop)
+	<-done
+}
+
+func TestNewBoundedLockFreeQueue(t *testing.T) {
+	tests := []struct {
+		capacity int
+		want     int
+	}{
+		{capacity: 1, want: 1},
+		{capacity: 5, want: 8},
+		{capacity: 16, want: 16},
+	}
+	for _, tt := range tests {
+		if got := NewBoundedLockFreeQueue[int](tt.capacity).Capacity(); got != tt.want {
+			t.Errorf("Capacity() = %v, want %v", got, tt.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewBoundedLockFreeQueue(0) did not panic")
+		}
+	}()
+	NewBoundedLockFreeQueue[int](0)
+}
+
+func TestBoundedLockFreeQueue_Enqueue(t *testing.T) {
+	q := NewBoundedLockFreeQueue[int](4)
+	for i := 0; i < 4; i++ {
+		if err := q.Enqueue(i); err != nil {
+			t.Fatalf("Enqueue(%v) error = %v", i, err)
+		}
+	}
+	if err := q.Enqueue(4); err != ErrQueueFull {
+		t.Errorf("Enqueue() error = %v, want %v", err, ErrQueueFull)
+	}
+	if got := q.Size(); got != 4 {
+		t.Errorf("Size() = %v, want %v", got, 4)
+	}
+
+	// Wrap around the array a few times.
+	for i := 4; i < 20; i++ {
+		if got, err := q.Dequeue(); got != i-4 || err != nil {
+			t.Fatalf("Dequeue() = %v, %v, want %v, %v", got, err, i-4, nil)
+		}
+		if err := q.Enqueue(i); err != nil {
+			t.Fatalf("Enqueue(%v) error = %v", i, err)
+		}
+	}
+}
+
+func TestBoundedLockFreeQueue_Dequeue(t *testing.T) {
+	q := NewBoundedLockFreeQueue[string](4)
+	if _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	_ = q.Enqueue("1")
+	_ = q.Enqueue("2")
+	if got, err := q.Dequeue(); got != "1" || err != nil {
+		t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, "1", nil)
+	}
+	if got := q.Size(); got != 1 {
+		t.Errorf("Size() = %v, want %v", got, 1)
+	}
+}
+
+func TestBoundedLockFreeQueue_Peek(t *testing.T) {
+	q := NewBoundedLockFreeQueue[string](4)
+	if _, err := q.Peek(); err != ErrEmptyQueue {
+		t.Errorf("Peek() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() = false, want true")
+	}
+	_ = q.Enqueue("1")
+	if got, err := q.Peek(); got != "1" || err != nil {
+		t.Errorf("Peek() = %v, %v, want %v, %v", got, err, "1", nil)
+	}
+	if q.IsEmpty() {
+		t.Errorf("Peek() removed the item")
+	}
+}
+
+func TestBoundedLockFreeQueue_Values(t *testing.T) {
+	q := NewBoundedLockFreeQueue[int](4)
+	// Wrap around the array so that the items do not start at the first slot.
+	for i := 0; i < 6; i++ {
+		_ = q.Enqueue(i)
+		if i >= 3 {
+			_, _ = q.Dequeue()
+		}
+	}
+	got := make([]int, 3)
+	if err := q.CopyTo(got, 0); err != nil {
+		t.Fatalf("CopyTo() error = %v", err)
+	}
+	if want := []int{3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CopyTo() got = %v, want %v", got, want)
+	}
+
+	q.Clear()
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() after Clear() = false, want true")
+	}
+}
+
+func TestBoundedLockFreeQueue_ValuesConcurrent(t *testing.T) {
+	q := NewBoundedLockFreeQueue[int](64)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				q.Contains(-1)
+			}
+		}
+	}()
+	stressFIFO(t, q, 2, 2, 1000)
+	close(stop)
+	<-done
+}
+
+func TestBoundedLockFreeQueue_Stress(t *testing.T) {
+	stressFIFO(t, NewBoundedLockFreeQueue[int](64), 4, 4, 2000)
+}
+
+func TestBoundedLockFreeQueue_StressPeek(t *testing.T) {
+	stressPeek(t, NewBoundedLockFreeQueue[int](64), 4, 2000)
+}
+
+func BenchmarkLockFreeQueue_Contention(b *testing.B) {
+	q := NewLockFreeQueue[int]()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			_ = q.Enqueue(i)
+			_, _ = q.Dequeue()
+		}
+	})
+}
+
+func BenchmarkBoundedLockFreeQueue_Contention(b *testing.B) {
+	q := NewBoundedLockFreeQueue[int](1024)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			_ = q.Enqueue(i)
+			_, _ = q.Dequeue()
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"sync/atomic"
+)
+
+// lockFreeStackNode is an immutable node in the linked list that backs a
+// LockFreeStack.
+type lockFreeStackNode[T any] struct {
+	value T
+	next  *lockFreeStackNode[T]
+}
+
+// LockFreeStack implements a LIFO data structure using Treiber's algorithm.
+// Push and Pop update the top of the stack with a compare-and-swap instead of
+// taking a lock, so goroutines never block each other. It is thread-safe.
+//
+// LockFreeStack and ConcurrentStack both implement IConcurrentStack, so the
+// two can be used interchangeably. Unlike ConcurrentStack, it cannot be
+// closed.
+//
+// A LockFreeStack must be created with NewLockFreeStack or
+// NewLockFreeStackWithEqualityComparer.
+type LockFreeStack[T any] struct {
+	head     atomic.Pointer[lockFreeStackNode[T]]
+	size     atomic.Int64
+	comparer EqualityComparer[T]
+}
+
+// NewLockFreeStack returns a new stack with the given initial items. The last
+// item is at the top of the stack.
+func NewLockFreeStack[T comparable](values ...T) *LockFreeStack[T] {
+	return NewLockFreeStackWithEqualityComparer(DefaultEqualityComparer[T], values...)
+}
+
+// NewLockFreeStackWithEqualityComparer returns a new stack with the given
+// initial items that uses the given comparer to find items. The last item is
+// at the top of the stack.
+func NewLockFreeStackWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *LockFreeStack[T] {
+	s := &LockFreeStack[T]{comparer: comparer}
+	for _, v := range values {
+		_ = s.Push(v)
+	}
+	return s
+}
+
+// Push adds an item to the top of the stack. The error is always nil; it is
+// returned so that LockFreeStack implements IConcurrentStack.
+func (s *LockFreeStack[T]) Push(item T) error {
+	n := &lockFreeStackNode[T]{value: item}
+	for {
+		n.next = s.head.Load()
+		if s.head.CompareAndSwap(n.next, n) {
+			s.size.Add(1)
+			return nil
+		}
+	}
+}
+
+// Pop removes and returns the item at the top of the stack. If the stack is
+// empty, an error is returned.
+func (s *LockFreeStack[T]) Pop() (T, error) {
+	for {
+		top := s.head.Load()
+		if top == nil {
+			var zero T
+			return zero, ErrEmptyStack
+		}
+		if s.head.CompareAndSwap(top, top.next) {
+			s.size.Add(-1)
+			return top.value, nil
+		}
+	}
+}
+
+// Peek returns the item at the top of the stack without removing it. If the
+// stack is empty, an error is returned.
+func (s *LockFreeStack[T]) Peek() (T, error) {
+	top := s.head.Load()
+	if top == nil {
+		var zero T
+		return zero, ErrEmptyStack
+	}
+	return top.value, nil
+}
+
+// IsEmpty returns true if the stack is empty.
+func (s *LockFreeStack[T]) IsEmpty() bool {
+	return s.head.Load() == nil
+}
+
+// Size returns the number of items in the stack. The count is updated after
+// each push or pop takes effect, so while other goroutines are using the stack
+// the result is only an approximation.
+func (s *LockFreeStack[T]) Size() int {
+	n := s.size.Load()
+	if n < 0 {
+		return 0
+	}
+	return int(n)
+}
+
+// Clear removes all items from the stack. Items pushed by other goroutines
+// while Clear runs may or may not be removed.
+func (s *LockFreeStack[T]) Clear() {
+	n := 0
+	for top := s.head.Swap(nil); top != nil; top = top.next {
+		n++
+	}
+	s.size.Add(int64(-n))
+}
+
+// Contains returns true if the stack contains the given item. It searches a
+// snapshot of the stack, like Values.
+func (s *LockFreeStack[T]) Contains(item T) bool {
+	for v := range s.Values() {
+		if s.comparer(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// CopyTo copies the items in the stack, from top to bottom, to the given
+// slice, starting at the given index. It copies a snapshot of the stack, like
+// Values. If the index is out of range, an error is returned. If the slice is
+// not large enough to hold all the items, an error is returned.
+func (s *LockFreeStack[T]) CopyTo(items []T, index int) error {
+	return copySnapshot(s.Values(), items, index)
+}
+
+// Values returns an iterator over the items in the stack, from top to bottom,
+// without removing them. The nodes of the stack are never modified once
+// pushed, so the iterator sees a consistent snapshot of the stack taken when
+// iteration starts.
+func (s *LockFreeStack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.head.Load(); n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops items from the top of the stack as they
+// are consumed. Items pushed by other goroutines during iteration are drained
+// as well, and other consumers may receive some of the items. Iteration ends
+// when the stack is empty. If the loop is exited early, the items that were
+// not consumed remain on the stack.
+func (s *LockFreeStack[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			item, err := s.Pop()
+			if err != nil {
+				return
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the stack, from bottom to top.
+func (s *LockFreeStack[T]) String() string {
+	var items []T
+	for v := range s.Values() {
+		items = append(items, v)
+	}
+	reverse(items)
+	return fmt.Sprintf("%v", items)
+}
@@ -0,0 +1,191 @@
+package collections
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestNewLockFreeStack(t *testing.T) {
+	s := NewLockFreeStack[int](1, 2, 3)
+	if got := s.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+	if got, _ := s.Peek(); got != 3 {
+		t.Errorf("Peek() = %v, want %v", got, 3)
+	}
+	if got := s.String(); got != "[1 2 3]" {
+		t.Errorf("String() = %v, want %v", got, "[1 2 3]")
+	}
+}
+
+func TestLockFreeStack_Pop(t *testing.T) {
+	type testCase[T any] struct {
+		name    string
+		s       *LockFreeStack[T]
+		want    T
+		wantErr bool
+	}
+	tests := []testCase[string]{
+		{
+			name:    "Empty",
+			s:       NewLockFreeStack[string](),
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "NotEmpty",
+			s:       NewLockFreeStack[string]("1", "2", "3"),
+			want:    "3",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.s.Pop()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Pop() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Pop() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockFreeStack_Peek(t *testing.T) {
+	s := NewLockFreeStack[string]()
+	if _, err := s.Peek(); err != ErrEmptyStack {
+		t.Errorf("Peek() error = %v, want %v", err, ErrEmptyStack)
+	}
+	_ = s.Push("1")
+	if got, err := s.Peek(); got != "1" || err != nil {
+		t.Errorf("Peek() = %v, %v, want %v, %v", got, err, "1", nil)
+	}
+	if s.IsEmpty() {
+		t.Errorf("Peek() removed the item")
+	}
+}
+
+func TestLockFreeStack_Values(t *testing.T) {
+	s := NewLockFreeStack[int](1, 2, 3)
+
+	var got []int
+	for v := range s.Values() {
+		// The nodes are immutable, so pushing during iteration does not
+		// affect the iterator.
+		_ = s.Push(v * 10)
+		got = append(got, v)
+	}
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if got := s.Size(); got != 6 {
+		t.Errorf("Size() = %v, want %v", got, 6)
+	}
+}
+
+func TestLockFreeStack_Drain(t *testing.T) {
+	s := NewLockFreeStack[int](1, 2, 3)
+
+	var got []int
+	for v := range s.Drain() {
+		got = append(got, v)
+	}
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Drain() = %v, want %v", got, want)
+	}
+	if !s.IsEmpty() {
+		t.Errorf("Drain() left %v items", s.Size())
+	}
+}
+
+func TestLockFreeStack_Clear(t *testing.T) {
+	s := NewLockFreeStack[int](1, 2, 3)
+	s.Clear()
+	if got := s.Size(); got != 0 {
+		t.Errorf("Size() = %v, want %v", got, 0)
+	}
+	if _, err := s.Pop(); err != ErrEmptyStack {
+		t.Errorf("Pop() error = %v, want %v", err, ErrEmptyStack)
+	}
+}
+
+func TestLockFreeStack_Concurrent(t *testing.T) {
+	const goroutines, perGoroutine = 8, 1000
+	s := NewLockFreeStack[int]()
+
+	var wg sync.WaitGroup
+	results := make(chan int, goroutines*perGoroutine)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				_ = s.Push(g*perGoroutine + i)
+				if i%2 == 1 {
+					item, err := s.Pop()
+					if err != nil {
+						t.Errorf("Pop() error = %v", err)
+						return
+					}
+					results <- item
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for item := range s.Drain() {
+		results <- item
+	}
+	close(results)
+
+	seen := make(map[int]bool)
+	for item := range results {
+		if seen[item] {
+			t.Fatalf("item %v popped twice", item)
+		}
+		seen[item] = true
+	}
+	if len(seen) != goroutines*perGoroutine {
+		t.Errorf("popped %v distinct items, want %v", len(seen), goroutines*perGoroutine)
+	}
+	if got := s.Size(); got != 0 {
+		t.Errorf("Size() = %v, want %v", got, 0)
+	}
+}
+
+func BenchmarkLockFreeStack_Contention(b *testing.B) {
+	s := NewLockFreeStack[int]()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			_ = s.Push(i)
+			_, _ = s.Pop()
+		}
+	})
+}
+
+func ExampleLockFreeStack() {
+	stack := NewLockFreeStack[int]()
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = stack.Push(i)
+		}(i)
+	}
+	wg.Wait()
+
+	sum := 0
+	for item := range stack.Drain() {
+		sum += item
+	}
+	fmt.Println(sum)
+	// Output: 6
+}
@@ -0,0 +1,323 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// OrderedDictionary implements a collection of keys and values in which each
+// key appears at most once and the entries are kept in the order they were
+// added. Keys can be looked up in constant time, and entries can also be read,
+// inserted and removed by index. Inserting or removing anywhere but the end
+// takes O(n) time. It is not thread-safe.
+//
+// An OrderedDictionary must be created with NewOrderedDictionary or
+// NewOrderedDictionaryWithEqualityComparer, including before it is passed to
+// json.Unmarshal.
+type OrderedDictionary[K any, V any] struct {
+	entries []dictionaryEntry[K, V]
+	index   Dictionary[K, int]
+}
+
+// NewOrderedDictionary returns a new, empty dictionary whose keys are compared
+// with the == operator.
+func NewOrderedDictionary[K comparable, V any]() *OrderedDictionary[K, V] {
+	return &OrderedDictionary[K, V]{index: *NewDictionary[K, int]()}
+}
+
+// NewOrderedDictionaryWithEqualityComparer returns a new, empty dictionary
+// whose keys are compared with the given comparer. The hash function must
+// return the same value for any two keys that the comparer considers equal.
+func NewOrderedDictionaryWithEqualityComparer[K any, V any](comparer EqualityComparer[K], hash func(K) uint64) *OrderedDictionary[K, V] {
+	return &OrderedDictionary[K, V]{index: *NewDictionaryWithEqualityComparer[K, int](comparer, hash)}
+}
+
+// Add adds the given key and value to the end of the dictionary. If the key is
+// already in the dictionary, ErrDuplicateKey is returned and the dictionary is
+// not changed.
+func (d *OrderedDictionary[K, V]) Add(key K, value V) error {
+	return d.Insert(len(d.entries), key, value)
+}
+
+// Set sets the value for the given key. If the key is already in the
+// dictionary, it keeps its position; otherwise it is added to the end.
+func (d *OrderedDictionary[K, V]) Set(key K, value V) {
+	if i, ok := d.index.TryGet(key); ok {
+		d.entries[i].value = value
+		return
+	}
+	_ = d.Insert(len(d.entries), key, value)
+}
+
+// TryGet returns the value for the given key and true. If the key is not
+// found, the zero value and false are returned.
+func (d *OrderedDictionary[K, V]) TryGet(key K) (V, bool) {
+	i, ok := d.index.TryGet(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return d.entries[i].value, true
+}
+
+// Get returns the value for the given key. If the key is not found,
+// ErrKeyNotFound is returned.
+func (d *OrderedDictionary[K, V]) Get(key K) (V, error) {
+	value, ok := d.TryGet(key)
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+// Remove removes the given key and its value from the dictionary. If the key
+// is not found, false is returned, otherwise true is returned.
+func (d *OrderedDictionary[K, V]) Remove(key K) bool {
+	i, ok := d.index.TryGet(key)
+	if !ok {
+		return false
+	}
+	return d.RemoveAt(i) == nil
+}
+
+// ContainsKey returns true if the dictionary contains the given key.
+func (d *OrderedDictionary[K, V]) ContainsKey(key K) bool {
+	return d.index.ContainsKey(key)
+}
+
+// IndexOfKey returns the index of the given key. If the key is not found, -1
+// is returned.
+func (d *OrderedDictionary[K, V]) IndexOfKey(key K) int {
+	i, ok := d.index.TryGet(key)
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// GetAt returns the key and value at the given index. If the index is out of
+// range, an error is returned.
+func (d *OrderedDictionary[K, V]) GetAt(index int) (K, V, error) {
+	if index < 0 || index >= len(d.entries) {
+		var key K
+		var value V
+		return key, value, ErrIndexOutOfRange
+	}
+
+	e := d.entries[index]
+	return e.key, e.value, nil
+}
+
+// SetAt sets the value at the given index. If the index is out of range, an
+// error is returned.
+func (d *OrderedDictionary[K, V]) SetAt(index int, value V) error {
+	if index < 0 || index >= len(d.entries) {
+		return ErrIndexOutOfRange
+	}
+
+	d.entries[index].value = value
+	return nil
+}
+
+// Insert inserts the given key and value at the given index. If the index is
+// out of range, ErrIndexOutOfRange is returned. If the key is already in the
+// dictionary, ErrDuplicateKey is returned.
+func (d *OrderedDictionary[K, V]) Insert(index int, key K, value V) error {
+	if index < 0 || index > len(d.entries) {
+		return ErrIndexOutOfRange
+	}
+
+	if err := d.index.Add(key, index); err != nil {
+		return err
+	}
+
+	d.entries = insertRange(d.entries, index, []dictionaryEntry[K, V]{{key: key, value: value}})
+	d.reindex(index + 1)
+	return nil
+}
+
+// RemoveAt removes the key and value at the given index. If the index is out
+// of range, an error is returned.
+func (d *OrderedDictionary[K, V]) RemoveAt(index int) error {
+	if index < 0 || index >= len(d.entries) {
+		return ErrIndexOutOfRange
+	}
+
+	d.index.Remove(d.entries[index].key)
+	d.entries = removeRange(d.entries, index, 1)
+	d.reindex(index)
+	return nil
+}
+
+// Keys returns an iterator over the keys in the dictionary, in order. Changes
+// made to the dictionary during iteration are visible to the iterator.
+func (d *OrderedDictionary[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for i := 0; i < len(d.entries); i++ {
+			if !yield(d.entries[i].key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in the dictionary, in order.
+// Changes made to the dictionary during iteration are visible to the iterator.
+func (d *OrderedDictionary[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for i := 0; i < len(d.entries); i++ {
+			if !yield(d.entries[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the keys and values in the dictionary, in
+// order. Changes made to the dictionary during iteration are visible to the
+// iterator.
+func (d *OrderedDictionary[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := 0; i < len(d.entries); i++ {
+			if !yield(d.entries[i].key, d.entries[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of keys in the dictionary.
+func (d *OrderedDictionary[K, V]) Size() int {
+	return len(d.entries)
+}
+
+// IsEmpty returns true if the dictionary is empty.
+func (d *OrderedDictionary[K, V]) IsEmpty() bool {
+	return len(d.entries) == 0
+}
+
+// Clear removes all keys and values from the dictionary.
+func (d *OrderedDictionary[K, V]) Clear() {
+	d.entries = nil
+	d.index.Clear()
+}
+
+// String returns a string representation of the dictionary, in the same format
+// as a Go map but in order.
+func (d *OrderedDictionary[K, V]) String() string {
+	var b strings.Builder
+	b.WriteString("map[")
+	for i, e := range d.entries {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%v:%v", e.key, e.value)
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// MarshalJSON encodes the dictionary as a JSON object whose members are in the
+// same order as the entries. Keys that do not encode as JSON strings, such as
+// numbers, are encoded as strings holding their JSON encoding.
+func (d *OrderedDictionary[K, V]) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, e := range d.entries {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		key, err := json.Marshal(e.key)
+		if err != nil {
+			return nil, err
+		}
+		if key[0] != '"' {
+			if key, err = json.Marshal(string(key)); err != nil {
+				return nil, err
+			}
+		}
+		b.Write(key)
+		b.WriteByte(':')
+
+		value, err := json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON replaces the entries in the dictionary with the members of the
+// given JSON object, in order. If a key appears more than once, it keeps the
+// position of its first appearance and the value of its last. Like the
+// encoding/json package, it does nothing if the data is the JSON null value.
+// If the dictionary was not created with a constructor, an error is returned.
+func (d *OrderedDictionary[K, V]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	if d.index.store == nil {
+		return errors.New("collections: cannot unmarshal into an OrderedDictionary that was not created with a constructor")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != json.Delim('{') {
+		return fmt.Errorf("collections: cannot unmarshal %v into an OrderedDictionary", token)
+	}
+
+	d.Clear()
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key, err := unmarshalJSONKey[K](token.(string))
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		d.Set(key, value)
+	}
+
+	_, err = decoder.Token()
+	return err
+}
+
+// reindex updates the index of every entry from the given position onwards.
+func (d *OrderedDictionary[K, V]) reindex(from int) {
+	for i := from; i < len(d.entries); i++ {
+		d.index.Set(d.entries[i].key, i)
+	}
+}
+
+// unmarshalJSONKey decodes a key written by MarshalJSON. The key is first
+// decoded as a JSON string, which works for strings and types that implement
+// encoding.TextUnmarshaler, and otherwise as the JSON encoding it holds.
+func unmarshalJSONKey[K any](s string) (K, error) {
+	var key K
+	quoted, err := json.Marshal(s)
+	if err != nil {
+		return key, err
+	}
+	if json.Unmarshal(quoted, &key) == nil {
+		return key, nil
+	}
+	err = json.Unmarshal([]byte(s), &key)
+	return key, err
+}
@@ -0,0 +1,211 @@
+package collections
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkOrderedDictionary fails the test if the dictionary does not hold the
+// expected keys, in order, or if its index is out of date.
+func checkOrderedDictionary[V any](t *testing.T, d *OrderedDictionary[string, V], want ...string) {
+	t.Helper()
+
+	if got := slices.Collect(d.Keys()); !slices.Equal(got, want) {
+		t.Fatalf("Keys() = %v, want %v", got, want)
+	}
+	for i, key := range want {
+		if got := d.IndexOfKey(key); got != i {
+			t.Fatalf("IndexOfKey(%v) = %v, want %v", key, got, i)
+		}
+	}
+	if got := d.Size(); got != len(want) {
+		t.Fatalf("Size() = %v, want %v", got, len(want))
+	}
+}
+
+func TestOrderedDictionary_Add(t *testing.T) {
+	d := NewOrderedDictionary[string, int]()
+	_ = d.Add("c", 3)
+	_ = d.Add("a", 1)
+	_ = d.Add("b", 2)
+	if err := d.Add("a", 4); err != ErrDuplicateKey {
+		t.Errorf("Add() error = %v, want %v", err, ErrDuplicateKey)
+	}
+	checkOrderedDictionary(t, d, "c", "a", "b")
+
+	d.Set("a", 10)
+	d.Set("d", 4)
+	checkOrderedDictionary(t, d, "c", "a", "b", "d")
+	if got, err := d.Get("a"); got != 10 || err != nil {
+		t.Errorf("Get() = %v, %v, want %v, %v", got, err, 10, nil)
+	}
+	if _, err := d.Get("z"); err != ErrKeyNotFound {
+		t.Errorf("Get() error = %v, want %v", err, ErrKeyNotFound)
+	}
+	if _, ok := d.TryGet("z"); ok {
+		t.Errorf("TryGet() = true, want false")
+	}
+	if got := d.String(); got != "map[c:3 a:10 b:2 d:4]" {
+		t.Errorf("String() = %v", got)
+	}
+}
+
+func TestOrderedDictionary_Insert(t *testing.T) {
+	d := NewOrderedDictionary[string, int]()
+	_ = d.Add("a", 1)
+	_ = d.Add("c", 3)
+
+	if err := d.Insert(1, "b", 2); err != nil {
+		t.Errorf("Insert() error = %v", err)
+	}
+	if err := d.Insert(0, "z", 0); err != nil {
+		t.Errorf("Insert() error = %v", err)
+	}
+	checkOrderedDictionary(t, d, "z", "a", "b", "c")
+
+	if err := d.Insert(5, "y", 0); err != ErrIndexOutOfRange {
+		t.Errorf("Insert() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if err := d.Insert(0, "a", 0); err != ErrDuplicateKey {
+		t.Errorf("Insert() error = %v, want %v", err, ErrDuplicateKey)
+	}
+	checkOrderedDictionary(t, d, "z", "a", "b", "c")
+
+	key, value, err := d.GetAt(2)
+	if key != "b" || value != 2 || err != nil {
+		t.Errorf("GetAt() = %v, %v, %v, want %v, %v, %v", key, value, err, "b", 2, nil)
+	}
+	if _, _, err := d.GetAt(4); err != ErrIndexOutOfRange {
+		t.Errorf("GetAt() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if err := d.SetAt(2, 20); err != nil {
+		t.Errorf("SetAt() error = %v", err)
+	}
+	if got, _ := d.Get("b"); got != 20 {
+		t.Errorf("SetAt() did not set the value, got %v", got)
+	}
+	if err := d.SetAt(-1, 0); err != ErrIndexOutOfRange {
+		t.Errorf("SetAt() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+}
+
+func TestOrderedDictionary_Remove(t *testing.T) {
+	d := NewOrderedDictionary[string, int]()
+	for i, key := range []string{"a", "b", "c", "d"} {
+		_ = d.Add(key, i)
+	}
+
+	if err := d.RemoveAt(1); err != nil {
+		t.Errorf("RemoveAt() error = %v", err)
+	}
+	checkOrderedDictionary(t, d, "a", "c", "d")
+	if err := d.RemoveAt(3); err != ErrIndexOutOfRange {
+		t.Errorf("RemoveAt() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	if !d.Remove("a") || d.Remove("a") {
+		t.Errorf("Remove() did not remove the key exactly once")
+	}
+	checkOrderedDictionary(t, d, "c", "d")
+	if d.ContainsKey("a") || d.IndexOfKey("a") != -1 {
+		t.Errorf("Remove() left the key in the index")
+	}
+
+	d.Clear()
+	if !d.IsEmpty() || d.ContainsKey("c") {
+		t.Errorf("Clear() left %v keys", d.Size())
+	}
+}
+
+func TestOrderedDictionary_Iterators(t *testing.T) {
+	d := NewOrderedDictionary[string, int]()
+	_ = d.Add("b", 2)
+	_ = d.Add("a", 1)
+
+	if got := slices.Collect(d.Values()); !slices.Equal(got, []int{2, 1}) {
+		t.Errorf("Values() = %v, want %v", got, []int{2, 1})
+	}
+
+	var keys []string
+	for k, v := range d.All() {
+		keys = append(keys, k)
+		if v == 2 {
+			_ = d.Add("c", 3)
+		}
+	}
+	if want := []string{"b", "a", "c"}; !slices.Equal(keys, want) {
+		t.Errorf("All() = %v, want %v", keys, want)
+	}
+}
+
+func TestOrderedDictionary_EqualityComparer(t *testing.T) {
+	d := NewOrderedDictionaryWithEqualityComparer[[]int, string](slices.Equal[[]int], hashInts)
+	_ = d.Add([]int{2}, "b")
+	_ = d.Insert(0, []int{1}, "a")
+
+	if got := d.IndexOfKey([]int{2}); got != 1 {
+		t.Errorf("IndexOfKey() = %v, want %v", got, 1)
+	}
+	if err := d.Add([]int{1}, "c"); err != ErrDuplicateKey {
+		t.Errorf("Add() error = %v, want %v", err, ErrDuplicateKey)
+	}
+}
+
+func TestOrderedDictionary_JSON(t *testing.T) {
+	d := NewOrderedDictionary[string, int]()
+	_ = d.Add("zebra", 1)
+	_ = d.Add("apple", 2)
+	_ = d.Add("mango", 3)
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"zebra":1,"apple":2,"mango":3}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	// A struct field must be created before unmarshaling into it.
+	var doc struct {
+		Fruit *OrderedDictionary[string, int] `json:"fruit"`
+	}
+	if err := json.Unmarshal([]byte(`{"fruit": {"a": 1}}`), &doc); err == nil {
+		t.Errorf("Unmarshal() into a zero value did not fail")
+	}
+	doc.Fruit = NewOrderedDictionary[string, int]()
+	if err := json.Unmarshal([]byte(`{"fruit": {"b": 1, "a": 2, "b": 3}}`), &doc); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	checkOrderedDictionary(t, doc.Fruit, "b", "a")
+	if got, _ := doc.Fruit.Get("b"); got != 3 {
+		t.Errorf("Unmarshal() value = %v, want %v", got, 3)
+	}
+
+	if err := json.Unmarshal([]byte(`[1, 2]`), doc.Fruit); err == nil {
+		t.Errorf("Unmarshal() of an array did not fail")
+	}
+}
+
+func TestOrderedDictionary_JSONKeys(t *testing.T) {
+	d := NewOrderedDictionary[int, []string]()
+	_ = d.Add(10, []string{"x"})
+	_ = d.Add(2, nil)
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"10":["x"],"2":null}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	got := NewOrderedDictionary[int, []string]()
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.entries, d.entries) {
+		t.Errorf("Unmarshal() = %v, want %v", got, d)
+	}
+}
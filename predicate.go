@@ -3,3 +3,23 @@ package collections
 // Predicate is a function that returns true or false for a given input. It is
 // used to filter collections.
 type Predicate[T any] func(T) bool
+
+// removeAll removes the items that match the given predicate from the slice in
+// a single pass, preserving the order of the remaining items. The vacated
+// slots at the end of the slice are zeroed so that they can be garbage
+// collected. It returns the compacted slice and the number of items removed.
+func removeAll[T any](items []T, match Predicate[T]) ([]T, int) {
+	n := 0
+	for _, v := range items {
+		if !match(v) {
+			items[n] = v
+			n++
+		}
+	}
+
+	var zero T
+	for i := n; i < len(items); i++ {
+		items[i] = zero
+	}
+	return items[:n], len(items) - n
+}
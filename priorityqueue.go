@@ -0,0 +1,351 @@
+package collections
+
+import (
+	"fmt"
+	"sync"
+)
+
+// PriorityQueue implements a queue in which the smallest item, as determined
+// by a comparer, is always at the front. It is backed by a binary heap. Items
+// that compare as equal are not dequeued in any particular order. The comparer
+// only orders items; Contains finds items with a separate equality comparer.
+// It is not thread-safe.
+type PriorityQueue[T any] struct {
+	items            []T
+	comparer         Comparer[T]
+	equalityComparer EqualityComparer[T]
+}
+
+// NewPriorityQueue returns a new priority queue that orders items using the
+// given comparer, with the given initial items.
+func NewPriorityQueue[T comparable](comparer Comparer[T], values ...T) *PriorityQueue[T] {
+	return NewPriorityQueueWithEqualityComparer(comparer, DefaultEqualityComparer[T], values...)
+}
+
+// NewPriorityQueueWithEqualityComparer returns a new priority queue that
+// orders items using the given comparer and finds items using the given
+// equality comparer, with the given initial items.
+func NewPriorityQueueWithEqualityComparer[T any](comparer Comparer[T], equalityComparer EqualityComparer[T], values ...T) *PriorityQueue[T] {
+	items := make([]T, len(values))
+	copy(items, values)
+	heapify(items, comparer)
+	return &PriorityQueue[T]{items: items, comparer: comparer, equalityComparer: equalityComparer}
+}
+
+// Enqueue adds an item to the queue.
+func (q *PriorityQueue[T]) Enqueue(item T) {
+	q.items = append(q.items, item)
+	heapUp(q.items, len(q.items)-1, q.comparer)
+}
+
+// Dequeue removes and returns the smallest item in the queue. If the queue is
+// empty, an error is returned.
+func (q *PriorityQueue[T]) Dequeue() (T, error) {
+	var zero T
+	if len(q.items) == 0 {
+		return zero, ErrEmptyQueue
+	}
+
+	var item T
+	q.items, item = heapPop(q.items, q.comparer)
+	return item, nil
+}
+
+// EnqueueDequeue adds an item to the queue, then removes and returns the
+// smallest item. It is more efficient than calling Enqueue followed by
+// Dequeue.
+func (q *PriorityQueue[T]) EnqueueDequeue(item T) T {
+	if len(q.items) == 0 || q.comparer(item, q.items[0]) <= 0 {
+		return item
+	}
+
+	item, q.items[0] = q.items[0], item
+	heapDown(q.items, 0, q.comparer)
+	return item
+}
+
+// DequeueEnqueue removes and returns the smallest item in the queue, then adds
+// the given item to the queue. It is more efficient than calling Dequeue
+// followed by Enqueue. If the queue is empty, an error is returned and the item
+// is not added.
+func (q *PriorityQueue[T]) DequeueEnqueue(item T) (T, error) {
+	var zero T
+	if len(q.items) == 0 {
+		return zero, ErrEmptyQueue
+	}
+
+	item, q.items[0] = q.items[0], item
+	heapDown(q.items, 0, q.comparer)
+	return item, nil
+}
+
+// Peek returns the smallest item in the queue without removing it. If the
+// queue is empty, an error is returned.
+func (q *PriorityQueue[T]) Peek() (T, error) {
+	var zero T
+	if len(q.items) == 0 {
+		return zero, ErrEmptyQueue
+	}
+	return q.items[0], nil
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+// Size returns the number of items in the queue.
+func (q *PriorityQueue[T]) Size() int {
+	return len(q.items)
+}
+
+// Contains returns true if the queue contains an item that the equality
+// comparer considers equal to the given item. Items with the same priority
+// are not equal unless the equality comparer says so.
+func (q *PriorityQueue[T]) Contains(item T) bool {
+	return containsItem(q.items, item, q.equalityComparer)
+}
+
+// String returns a string representation of the queue. The items are not
+// listed in any particular order.
+func (q *PriorityQueue[T]) String() string {
+	return fmt.Sprintf("%v", q.items)
+}
+
+// Clear removes all items from the queue.
+func (q *PriorityQueue[T]) Clear() {
+	q.items = []T{}
+}
+
+// CopyTo copies the items in the queue to the given slice, starting at the
+// given index. The items are not copied in any particular order. If the index
+// is out of range, an error is returned. If the slice is not large enough to
+// hold all the items, an error is returned.
+func (q *PriorityQueue[T]) CopyTo(items []T, index int) error {
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < len(q.items) {
+		return ErrIndexOutOfRange
+	}
+
+	copy(items[index:], q.items)
+
+	return nil
+}
+
+// ConcurrentPriorityQueue implements a queue in which the smallest item, as
+// determined by a comparer, is always at the front. It is thread-safe.
+type ConcurrentPriorityQueue[T any] struct {
+	queue PriorityQueue[T]
+	mutex sync.RWMutex
+}
+
+// NewConcurrentPriorityQueue returns a new priority queue that orders items
+// using the given comparer, with the given initial items.
+func NewConcurrentPriorityQueue[T comparable](comparer Comparer[T], values ...T) *ConcurrentPriorityQueue[T] {
+	return NewConcurrentPriorityQueueWithEqualityComparer(comparer, DefaultEqualityComparer[T], values...)
+}
+
+// NewConcurrentPriorityQueueWithEqualityComparer returns a new priority queue
+// that orders items using the given comparer and finds items using the given
+// equality comparer, with the given initial items.
+func NewConcurrentPriorityQueueWithEqualityComparer[T any](comparer Comparer[T], equalityComparer EqualityComparer[T], values ...T) *ConcurrentPriorityQueue[T] {
+	return &ConcurrentPriorityQueue[T]{queue: *NewPriorityQueueWithEqualityComparer(comparer, equalityComparer, values...)}
+}
+
+// Enqueue adds an item to the queue.
+func (q *ConcurrentPriorityQueue[T]) Enqueue(item T) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.queue.Enqueue(item)
+}
+
+// Dequeue removes and returns the smallest item in the queue. If the queue is
+// empty, an error is returned.
+func (q *ConcurrentPriorityQueue[T]) Dequeue() (T, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.queue.Dequeue()
+}
+
+// EnqueueDequeue adds an item to the queue, then removes and returns the
+// smallest item, as a single atomic operation.
+func (q *ConcurrentPriorityQueue[T]) EnqueueDequeue(item T) T {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.queue.EnqueueDequeue(item)
+}
+
+// DequeueEnqueue removes and returns the smallest item in the queue, then adds
+// the given item to the queue, as a single atomic operation. If the queue is
+// empty, an error is returned and the item is not added.
+func (q *ConcurrentPriorityQueue[T]) DequeueEnqueue(item T) (T, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.queue.DequeueEnqueue(item)
+}
+
+// Peek returns the smallest item in the queue without removing it. If the
+// queue is empty, an error is returned.
+func (q *ConcurrentPriorityQueue[T]) Peek() (T, error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.queue.Peek()
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *ConcurrentPriorityQueue[T]) IsEmpty() bool {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.queue.IsEmpty()
+}
+
+// Size returns the number of items in the queue.
+func (q *ConcurrentPriorityQueue[T]) Size() int {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.queue.Size()
+}
+
+// Contains returns true if the queue contains an item that the equality
+// comparer considers equal to the given item.
+func (q *ConcurrentPriorityQueue[T]) Contains(item T) bool {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.queue.Contains(item)
+}
+
+// String returns a string representation of the queue. The items are not
+// listed in any particular order.
+func (q *ConcurrentPriorityQueue[T]) String() string {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.queue.String()
+}
+
+// Clear removes all items from the queue.
+func (q *ConcurrentPriorityQueue[T]) Clear() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.queue.Clear()
+}
+
+// CopyTo copies the items in the queue to the given slice, starting at the
+// given index. The items are not copied in any particular order. If the index
+// is out of range, an error is returned. If the slice is not large enough to
+// hold all the items, an error is returned.
+func (q *ConcurrentPriorityQueue[T]) CopyTo(items []T, index int) error {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.queue.CopyTo(items, index)
+}
+
+// prioritized pairs an element with its priority.
+type prioritized[E any, P any] struct {
+	element  E
+	priority P
+}
+
+// ElementPriorityQueue implements a queue of elements that each have a
+// separate priority. The element with the smallest priority, as determined by
+// a comparer, is always at the front. It is modelled on .NET's
+// PriorityQueue<TElement, TPriority>. It is not thread-safe.
+type ElementPriorityQueue[E any, P any] struct {
+	queue PriorityQueue[prioritized[E, P]]
+}
+
+// NewElementPriorityQueue returns a new, empty priority queue that orders
+// elements by their priority using the given comparer.
+func NewElementPriorityQueue[E any, P any](comparer Comparer[P]) *ElementPriorityQueue[E, P] {
+	return &ElementPriorityQueue[E, P]{
+		queue: PriorityQueue[prioritized[E, P]]{
+			comparer: func(a, b prioritized[E, P]) int {
+				return comparer(a.priority, b.priority)
+			},
+		},
+	}
+}
+
+// Enqueue adds an element with the given priority to the queue.
+func (q *ElementPriorityQueue[E, P]) Enqueue(element E, priority P) {
+	q.queue.Enqueue(prioritized[E, P]{element: element, priority: priority})
+}
+
+// Dequeue removes and returns the element with the smallest priority, along
+// with its priority. If the queue is empty, an error is returned.
+func (q *ElementPriorityQueue[E, P]) Dequeue() (E, P, error) {
+	item, err := q.queue.Dequeue()
+	return item.element, item.priority, err
+}
+
+// EnqueueDequeue adds an element with the given priority to the queue, then
+// removes and returns the element with the smallest priority, along with its
+// priority. It is more efficient than calling Enqueue followed by Dequeue.
+func (q *ElementPriorityQueue[E, P]) EnqueueDequeue(element E, priority P) (E, P) {
+	item := q.queue.EnqueueDequeue(prioritized[E, P]{element: element, priority: priority})
+	return item.element, item.priority
+}
+
+// DequeueEnqueue removes and returns the element with the smallest priority,
+// along with its priority, then adds the given element with the given priority
+// to the queue. It is more efficient than calling Dequeue followed by Enqueue.
+// If the queue is empty, an error is returned and the element is not added.
+func (q *ElementPriorityQueue[E, P]) DequeueEnqueue(element E, priority P) (E, P, error) {
+	item, err := q.queue.DequeueEnqueue(prioritized[E, P]{element: element, priority: priority})
+	return item.element, item.priority, err
+}
+
+// Peek returns the element with the smallest priority, along with its
+// priority, without removing it. If the queue is empty, an error is returned.
+func (q *ElementPriorityQueue[E, P]) Peek() (E, P, error) {
+	item, err := q.queue.Peek()
+	return item.element, item.priority, err
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *ElementPriorityQueue[E, P]) IsEmpty() bool {
+	return q.queue.IsEmpty()
+}
+
+// Size returns the number of elements in the queue.
+func (q *ElementPriorityQueue[E, P]) Size() int {
+	return q.queue.Size()
+}
+
+// Clear removes all elements from the queue.
+func (q *ElementPriorityQueue[E, P]) Clear() {
+	q.queue.Clear()
+}
+
+// CopyTo copies the elements in the queue to the given slice, starting at the
+// given index. The elements are not copied in any particular order. If the
+// index is out of range, an error is returned. If the slice is not large
+// enough to hold all the elements, an error is returned.
+func (q *ElementPriorityQueue[E, P]) CopyTo(elements []E, index int) error {
+	if index < 0 || index > len(elements) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(elements)-index < len(q.queue.items) {
+		return ErrIndexOutOfRange
+	}
+
+	for i, item := range q.queue.items {
+		elements[index+i] = item.element
+	}
+
+	return nil
+}
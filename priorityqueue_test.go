@@ -0,0 +1,394 @@
+package collections
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestNewPriorityQueue(t *testing.T) {
+	values := []int{5, 3, 8, 1}
+	q := NewPriorityQueue[int](compareInts, values...)
+
+	if got := q.Size(); got != 4 {
+		t.Errorf("Size() = %v, want %v", got, 4)
+	}
+	if got, _ := q.Peek(); got != 1 {
+		t.Errorf("Peek() = %v, want %v", got, 1)
+	}
+	if !reflect.DeepEqual(values, []int{5, 3, 8, 1}) {
+		t.Errorf("NewPriorityQueue() modified the given values: %v", values)
+	}
+}
+
+func TestPriorityQueue_Dequeue(t *testing.T) {
+	type testCase[T any] struct {
+		name    string
+		q       *PriorityQueue[T]
+		want    T
+		wantErr bool
+	}
+	tests := []testCase[int]{
+		{
+			name:    "Normal",
+			q:       NewPriorityQueue[int](compareInts, 3, 1, 2),
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "Empty",
+			q:       NewPriorityQueue[int](compareInts),
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.q.Dequeue()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Dequeue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Dequeue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_Order(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	q := NewPriorityQueue[int](compareInts)
+	var want []int
+	for i := 0; i < 1000; i++ {
+		v := r.Intn(100)
+		q.Enqueue(v)
+		want = append(want, v)
+	}
+	sort.Ints(want)
+
+	var got []int
+	for !q.IsEmpty() {
+		v, err := q.Dequeue()
+		if err != nil {
+			t.Fatalf("Dequeue() error = %v", err)
+		}
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dequeue() order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueue_Peek(t *testing.T) {
+	q := NewPriorityQueue[int](compareInts)
+	if _, err := q.Peek(); err != ErrEmptyQueue {
+		t.Errorf("Peek() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	q.Enqueue(2)
+	q.Enqueue(1)
+	if got, err := q.Peek(); got != 1 || err != nil {
+		t.Errorf("Peek() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if got := q.Size(); got != 2 {
+		t.Errorf("Peek() removed an item, Size() = %v", got)
+	}
+}
+
+func TestPriorityQueue_EnqueueDequeue(t *testing.T) {
+	tests := []struct {
+		name     string
+		q        *PriorityQueue[int]
+		item     int
+		want     int
+		wantNext int
+	}{
+		{name: "Smaller", q: NewPriorityQueue[int](compareInts, 2, 3), item: 1, want: 1, wantNext: 2},
+		{name: "Equal", q: NewPriorityQueue[int](compareInts, 2, 3), item: 2, want: 2, wantNext: 2},
+		{name: "Larger", q: NewPriorityQueue[int](compareInts, 2, 3), item: 4, want: 2, wantNext: 3},
+		{name: "Empty", q: NewPriorityQueue[int](compareInts), item: 4, want: 4, wantNext: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size := tt.q.Size()
+			if got := tt.q.EnqueueDequeue(tt.item); got != tt.want {
+				t.Errorf("EnqueueDequeue() = %v, want %v", got, tt.want)
+			}
+			if got := tt.q.Size(); got != size {
+				t.Errorf("Size() = %v, want %v", got, size)
+			}
+			if next, err := tt.q.Peek(); err == nil && next != tt.wantNext {
+				t.Errorf("Peek() = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_DequeueEnqueue(t *testing.T) {
+	q := NewPriorityQueue[int](compareInts, 2, 3)
+	if got, err := q.DequeueEnqueue(1); got != 2 || err != nil {
+		t.Errorf("DequeueEnqueue() = %v, %v, want %v, %v", got, err, 2, nil)
+	}
+	if got, _ := q.Peek(); got != 1 {
+		t.Errorf("Peek() = %v, want %v", got, 1)
+	}
+
+	empty := NewPriorityQueue[int](compareInts)
+	if _, err := empty.DequeueEnqueue(1); err != ErrEmptyQueue {
+		t.Errorf("DequeueEnqueue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	if !empty.IsEmpty() {
+		t.Errorf("DequeueEnqueue() added an item to an empty queue")
+	}
+}
+
+func TestPriorityQueue_Contains(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	byPriority := func(a, b task) int { return a.priority - b.priority }
+	a, b := task{"a", 1}, task{"b", 1}
+
+	// Items with the same priority are different items.
+	queues := map[string]Collection[task]{
+		"PriorityQueue":           NewPriorityQueue(byPriority, a),
+		"ConcurrentPriorityQueue": NewConcurrentPriorityQueue(byPriority, a),
+	}
+	for name, q := range queues {
+		t.Run(name, func(t *testing.T) {
+			if !q.Contains(a) {
+				t.Errorf("Contains(a) = false, want true")
+			}
+			if q.Contains(b) {
+				t.Errorf("Contains(b) = true, want false")
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_Clear(t *testing.T) {
+	q := NewPriorityQueue[int](compareInts, 1, 2, 3)
+	q.Clear()
+	if !q.IsEmpty() {
+		t.Errorf("Clear() left %v items", q.Size())
+	}
+}
+
+func TestPriorityQueue_CopyTo(t *testing.T) {
+	type args[T any] struct {
+		items []T
+		index int
+	}
+	type testCase[T any] struct {
+		name    string
+		q       *PriorityQueue[T]
+		args    args[T]
+		wantErr bool
+	}
+	tests := []testCase[int]{
+		{
+			name:    "Normal",
+			q:       NewPriorityQueue[int](compareInts, 3, 1, 2),
+			args:    args[int]{items: make([]int, 4), index: 1},
+			wantErr: false,
+		},
+		{
+			name:    "IndexOutOfRange",
+			q:       NewPriorityQueue[int](compareInts, 3, 1, 2),
+			args:    args[int]{items: make([]int, 3), index: 4},
+			wantErr: true,
+		},
+		{
+			name:    "DestinationTooSmall",
+			q:       NewPriorityQueue[int](compareInts, 3, 1, 2),
+			args:    args[int]{items: make([]int, 3), index: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.q.CopyTo(tt.args.items, tt.args.index); (err != nil) != tt.wantErr {
+				t.Errorf("CopyTo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			got := append([]int(nil), tt.args.items[tt.args.index:]...)
+			sort.Ints(got)
+			if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+				t.Errorf("CopyTo() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestConcurrentPriorityQueue(t *testing.T) {
+	q := NewConcurrentPriorityQueue[int](compareInts)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			q.Enqueue(i)
+		}(i)
+	}
+	wg.Wait()
+
+	if got := q.Size(); got != 100 {
+		t.Errorf("Size() = %v, want %v", got, 100)
+	}
+
+	results := make(chan int, 100)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				item, err := q.Dequeue()
+				if err != nil {
+					return
+				}
+				results <- item
+			}
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	seen := make(map[int]bool)
+	for item := range results {
+		seen[item] = true
+	}
+	if len(seen) != 100 {
+		t.Errorf("dequeued %v distinct items, want %v", len(seen), 100)
+	}
+}
+
+func TestConcurrentPriorityQueue_Methods(t *testing.T) {
+	q := NewConcurrentPriorityQueue[int](compareInts, 5, 3, 4)
+
+	if got, _ := q.Peek(); got != 3 {
+		t.Errorf("Peek() = %v, want %v", got, 3)
+	}
+	if got := q.EnqueueDequeue(1); got != 1 {
+		t.Errorf("EnqueueDequeue() = %v, want %v", got, 1)
+	}
+	if got, err := q.DequeueEnqueue(6); got != 3 || err != nil {
+		t.Errorf("DequeueEnqueue() = %v, %v, want %v, %v", got, err, 3, nil)
+	}
+	array := make([]int, 3)
+	if err := q.CopyTo(array, 0); err != nil {
+		t.Errorf("CopyTo() error = %v", err)
+	}
+	if got := q.String(); got != fmt.Sprint(array) {
+		t.Errorf("String() = %v, want %v", got, fmt.Sprint(array))
+	}
+	q.Clear()
+	if !q.IsEmpty() {
+		t.Errorf("Clear() left %v items", q.Size())
+	}
+	if _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+}
+
+func TestElementPriorityQueue(t *testing.T) {
+	q := NewElementPriorityQueue[string, int](compareInts)
+	q.Enqueue("low", 10)
+	q.Enqueue("high", 1)
+	q.Enqueue("medium", 5)
+
+	if got := q.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+	if element, priority, err := q.Peek(); element != "high" || priority != 1 || err != nil {
+		t.Errorf("Peek() = %v, %v, %v, want %v, %v, %v", element, priority, err, "high", 1, nil)
+	}
+
+	wants := []struct {
+		element  string
+		priority int
+	}{{"high", 1}, {"medium", 5}, {"low", 10}}
+	for _, want := range wants {
+		element, priority, err := q.Dequeue()
+		if element != want.element || priority != want.priority || err != nil {
+			t.Errorf("Dequeue() = %v, %v, %v, want %v, %v, %v", element, priority, err, want.element, want.priority, nil)
+		}
+	}
+	if _, _, err := q.Dequeue(); err != ErrEmptyQueue {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+}
+
+func TestElementPriorityQueue_FastPaths(t *testing.T) {
+	q := NewElementPriorityQueue[string, int](compareInts)
+	q.Enqueue("b", 2)
+
+	if element, priority := q.EnqueueDequeue("a", 1); element != "a" || priority != 1 {
+		t.Errorf("EnqueueDequeue() = %v, %v, want %v, %v", element, priority, "a", 1)
+	}
+	if element, priority, err := q.DequeueEnqueue("c", 3); element != "b" || priority != 2 || err != nil {
+		t.Errorf("DequeueEnqueue() = %v, %v, %v, want %v, %v, %v", element, priority, err, "b", 2, nil)
+	}
+
+	elements := make([]string, 1)
+	if err := q.CopyTo(elements, 0); err != nil || elements[0] != "c" {
+		t.Errorf("CopyTo() = %v, %v, want %v, %v", elements, err, []string{"c"}, nil)
+	}
+	if err := q.CopyTo(elements, 1); err != ErrIndexOutOfRange {
+		t.Errorf("CopyTo() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	q.Clear()
+	if !q.IsEmpty() {
+		t.Errorf("Clear() left %v elements", q.Size())
+	}
+}
+
+func BenchmarkPriorityQueue_EnqueueDequeue(b *testing.B) {
+	q := NewPriorityQueue[int](compareInts)
+	for i := 0; i < 1024; i++ {
+		q.Enqueue(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i)
+		_, _ = q.Dequeue()
+	}
+}
+
+func ExamplePriorityQueue() {
+	queue := NewPriorityQueue[int](func(a, b int) int { return a - b }, 5, 1, 3)
+	queue.Enqueue(2)
+	for !queue.IsEmpty() {
+		item, _ := queue.Dequeue()
+		fmt.Println(item)
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+	// 5
+}
+
+func ExampleElementPriorityQueue() {
+	queue := NewElementPriorityQueue[string, int](func(a, b int) int { return a - b })
+	queue.Enqueue("write report", 2)
+	queue.Enqueue("fix outage", 0)
+	queue.Enqueue("reply to email", 1)
+	for !queue.IsEmpty() {
+		task, priority, _ := queue.Dequeue()
+		fmt.Println(priority, task)
+	}
+	// Output:
+	// 0 fix outage
+	// 1 reply to email
+	// 2 write report
+}
@@ -1,66 +1,112 @@
 package collections
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"sync"
+	"time"
 )
 
 // Queue implements a FIFO data structure. It is not thread-safe.
+//
+// The items are stored in a ring buffer that grows and shrinks as items are
+// added and removed, so that a queue with a steady number of items does not
+// allocate.
 type Queue[T any] struct {
-	items []T
+	items    ring[T]
+	comparer EqualityComparer[T]
 }
 
 // NewQueue returns a new queue with the given initial items.
-func NewQueue[T any](values ...T) *Queue[T] {
-	return &Queue[T]{items: values}
+func NewQueue[T comparable](values ...T) *Queue[T] {
+	return &Queue[T]{items: newRing(values), comparer: DefaultEqualityComparer[T]}
+}
+
+// NewQueueWithEqualityComparer returns a new queue with the given initial
+// items that uses the given comparer to find items.
+func NewQueueWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *Queue[T] {
+	return &Queue[T]{items: newRing(values), comparer: comparer}
 }
 
 // Enqueue adds an item to the end of the queue.
 func (q *Queue[T]) Enqueue(item T) {
-	q.items = append(q.items, item)
+	q.items.pushBack(item)
 }
 
 // Dequeue removes and returns the item at the front of the queue. If the queue
 // is empty, an error is returned.
 func (q *Queue[T]) Dequeue() (T, error) {
 	var zero T
-	if len(q.items) == 0 {
+	if q.items.len() == 0 {
 		return zero, ErrEmptyQueue
 	}
 
-	item := q.items[0]
-	q.items = q.items[1:]
-	return item, nil
+	return q.items.popFront(), nil
 }
 
 // Peek returns the item at the front of the queue without removing it. If the
 // queue is empty, an error is returned.
 func (q *Queue[T]) Peek() (T, error) {
 	var zero T
-	if len(q.items) == 0 {
+	if q.items.len() == 0 {
 		return zero, ErrEmptyQueue
 	}
-	return q.items[0], nil
+	return q.items.front(), nil
 }
 
 // IsEmpty returns true if the queue is empty.
 func (q *Queue[T]) IsEmpty() bool {
-	return len(q.items) == 0
+	return q.items.len() == 0
 }
 
 // Size returns the number of items in the queue.
 func (q *Queue[T]) Size() int {
-	return len(q.items)
+	return q.items.len()
+}
+
+// Values returns an iterator over the items in the queue, from front to back,
+// without removing them. The queue should not be modified during iteration.
+func (q *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.items.len(); i++ {
+			if !yield(q.items.at(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that dequeues items from the front of the queue as
+// they are consumed. Iteration ends when the queue is empty. If the loop is
+// exited early, the items that were not consumed remain in the queue.
+func (q *Queue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			item, err := q.Dequeue()
+			if err != nil {
+				return
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
 }
 
 // String returns a string representation of the queue.
 func (q *Queue[T]) String() string {
-	return fmt.Sprintf("%v", q.items)
+	return fmt.Sprintf("%v", q.items.slice())
+}
+
+// Contains returns true if the queue contains the given item.
+func (q *Queue[T]) Contains(item T) bool {
+	return q.items.contains(item, q.comparer)
 }
 
 // Clear removes all items from the queue.
 func (q *Queue[T]) Clear() {
-	q.items = []T{}
+	q.items.clear()
 }
 
 // CopyTo copies the items in the queue to the given slice, starting at the
@@ -71,48 +117,267 @@ func (q *Queue[T]) CopyTo(items []T, index int) error {
 		return ErrIndexOutOfRange
 	}
 
-	if len(items)-index < len(q.items) {
+	if len(items)-index < q.items.len() {
 		return ErrIndexOutOfRange
 	}
 
-	copy(items[index:], q.items)
+	q.items.copyTo(items[index:])
 
 	return nil
 }
 
 // ConcurrentQueue implements a FIFO data structure. It is thread-safe.
+//
+// Like Queue, the items are stored in a ring buffer. A queue created with
+// NewBoundedConcurrentQueue holds at most a fixed number of items; the
+// EnqueueContext and DequeueContext methods block until the queue has room or
+// has an item, which lets the queue be used in place of a buffered channel.
+//
+// Like a channel, the queue can be closed to signal that no more items will be
+// enqueued. Consumers can keep dequeuing the items that remain; once the queue
+// is closed and empty, dequeuing returns ErrClosed and the channel returned by
+// Done is closed.
 type ConcurrentQueue[T any] struct {
-	items []T
-	mutex sync.RWMutex
+	items    ring[T]
+	capacity int
+	closed   bool
+	done     chan struct{}
+	notEmpty signal
+	notFull  signal
+	comparer EqualityComparer[T]
+	mutex    sync.RWMutex
 }
 
 // NewConcurrentQueue returns a new queue with the given initial items.
-func NewConcurrentQueue[T any](values ...T) *ConcurrentQueue[T] {
-	return &ConcurrentQueue[T]{items: values}
+func NewConcurrentQueue[T comparable](values ...T) *ConcurrentQueue[T] {
+	return &ConcurrentQueue[T]{items: newRing(values), comparer: DefaultEqualityComparer[T]}
 }
 
-// Enqueue adds an item to the end of the queue.
-func (q *ConcurrentQueue[T]) Enqueue(item T) {
+// NewConcurrentQueueWithEqualityComparer returns a new queue with the given
+// initial items that uses the given comparer to find items.
+func NewConcurrentQueueWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *ConcurrentQueue[T] {
+	return &ConcurrentQueue[T]{items: newRing(values), comparer: comparer}
+}
+
+// NewBoundedConcurrentQueue returns a new empty queue that holds at most
+// capacity items. It panics if capacity is not positive.
+func NewBoundedConcurrentQueue[T comparable](capacity int) *ConcurrentQueue[T] {
+	return NewBoundedConcurrentQueueWithEqualityComparer(capacity, DefaultEqualityComparer[T])
+}
+
+// NewBoundedConcurrentQueueWithEqualityComparer returns a new empty queue that
+// holds at most capacity items and uses the given comparer to find items. It
+// panics if capacity is not positive.
+func NewBoundedConcurrentQueueWithEqualityComparer[T any](capacity int, comparer EqualityComparer[T]) *ConcurrentQueue[T] {
+	if capacity <= 0 {
+		panic("collections: capacity of a bounded queue must be positive")
+	}
+	return &ConcurrentQueue[T]{capacity: capacity, comparer: comparer}
+}
+
+// Capacity returns the maximum number of items the queue can hold, or 0 if
+// the queue is unbounded.
+func (q *ConcurrentQueue[T]) Capacity() int {
+	return q.capacity
+}
+
+// Enqueue adds an item to the end of the queue. If the queue is bounded and
+// full, Enqueue blocks until there is room. If the queue is closed, ErrClosed
+// is returned.
+func (q *ConcurrentQueue[T]) Enqueue(item T) error {
+	return q.EnqueueContext(context.Background(), item)
+}
+
+// EnqueueContext adds an item to the end of the queue. If the queue is bounded
+// and full, EnqueueContext blocks until there is room or the context is done,
+// in which case the context's error is returned. If the queue is closed,
+// including while EnqueueContext is blocked, ErrClosed is returned.
+func (q *ConcurrentQueue[T]) EnqueueContext(ctx context.Context, item T) error {
+	for {
+		q.mutex.Lock()
+		if q.closed {
+			q.mutex.Unlock()
+			return ErrClosed
+		}
+		if !q.full() {
+			q.push(item)
+			q.mutex.Unlock()
+			return nil
+		}
+		ch := q.notFull.wait()
+		q.mutex.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// EnqueueTimeout adds an item to the end of the queue. If the queue is bounded
+// and full, EnqueueTimeout blocks until there is room or the timeout elapses,
+// in which case context.DeadlineExceeded is returned.
+func (q *ConcurrentQueue[T]) EnqueueTimeout(item T, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.EnqueueContext(ctx, item)
+}
+
+// TryEnqueue adds an item to the end of the queue without blocking. If the
+// queue is bounded and full, ErrQueueFull is returned. If the queue is closed,
+// ErrClosed is returned.
+func (q *ConcurrentQueue[T]) TryEnqueue(item T) error {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 
-	q.items = append(q.items, item)
+	if q.closed {
+		return ErrClosed
+	}
+	if q.full() {
+		return ErrQueueFull
+	}
+	q.push(item)
+	return nil
 }
 
 // Dequeue removes and returns the item at the front of the queue. If the queue
-// is empty, an error is returned.
+// is empty, ErrEmptyQueue is returned, or ErrClosed if the queue is also
+// closed.
 func (q *ConcurrentQueue[T]) Dequeue() (T, error) {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 
 	var zero T
-	if len(q.items) == 0 {
+	if q.items.len() == 0 {
+		if q.closed {
+			return zero, ErrClosed
+		}
 		return zero, ErrEmptyQueue
 	}
 
-	item := q.items[0]
-	q.items = q.items[1:]
-	return item, nil
+	return q.pop(), nil
+}
+
+// DequeueContext removes and returns the item at the front of the queue. If
+// the queue is empty, DequeueContext blocks until an item is enqueued or the
+// context is done, in which case the context's error is returned. If the queue
+// is closed and empty, including while DequeueContext is blocked, ErrClosed is
+// returned.
+func (q *ConcurrentQueue[T]) DequeueContext(ctx context.Context) (T, error) {
+	for {
+		q.mutex.Lock()
+		if q.items.len() > 0 {
+			item := q.pop()
+			q.mutex.Unlock()
+			return item, nil
+		}
+		if q.closed {
+			q.mutex.Unlock()
+			var zero T
+			return zero, ErrClosed
+		}
+		ch := q.notEmpty.wait()
+		q.mutex.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// DequeueTimeout removes and returns the item at the front of the queue. If
+// the queue is empty, DequeueTimeout blocks until an item is enqueued or the
+// timeout elapses, in which case context.DeadlineExceeded is returned.
+func (q *ConcurrentQueue[T]) DequeueTimeout(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.DequeueContext(ctx)
+}
+
+// TryDequeue removes and returns the item at the front of the queue without
+// blocking. If the queue is empty, ErrEmptyQueue is returned, or ErrClosed if
+// the queue is also closed. It behaves like Dequeue and exists to complement
+// TryEnqueue.
+func (q *ConcurrentQueue[T]) TryDequeue() (T, error) {
+	return q.Dequeue()
+}
+
+// Close marks the queue as closed. Enqueuing an item into a closed queue
+// returns ErrClosed, and blocked producers are woken up and return ErrClosed.
+// The items already in the queue can still be dequeued; blocked consumers are
+// woken up, and once the queue is empty they return ErrClosed. Closing a
+// closed queue has no effect.
+func (q *ConcurrentQueue[T]) Close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.notEmpty.broadcast()
+	q.notFull.broadcast()
+	q.checkDone()
+}
+
+// IsClosed returns true if the queue has been closed.
+func (q *ConcurrentQueue[T]) IsClosed() bool {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.closed
+}
+
+// Done returns a channel that is closed once the queue has been closed and
+// every remaining item has been dequeued.
+func (q *ConcurrentQueue[T]) Done() <-chan struct{} {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.done == nil {
+		q.done = make(chan struct{})
+		q.checkDone()
+	}
+	return q.done
+}
+
+// checkDone closes the done channel if the queue is closed and empty. The
+// caller must hold the lock.
+func (q *ConcurrentQueue[T]) checkDone() {
+	if !q.closed || q.items.len() > 0 || q.done == nil {
+		return
+	}
+	select {
+	case <-q.done:
+	default:
+		close(q.done)
+	}
+}
+
+// full returns true if the queue is bounded and has no room. The caller must
+// hold the lock.
+func (q *ConcurrentQueue[T]) full() bool {
+	return q.capacity > 0 && q.items.len() >= q.capacity
+}
+
+// push adds an item to the end of the queue and wakes any waiting consumers.
+// The caller must hold the lock.
+func (q *ConcurrentQueue[T]) push(item T) {
+	q.items.pushBack(item)
+	q.notEmpty.broadcast()
+}
+
+// pop removes the item at the front of the queue and wakes any waiting
+// producers. The caller must hold the lock and the queue must not be empty.
+func (q *ConcurrentQueue[T]) pop() T {
+	item := q.items.popFront()
+	q.notFull.broadcast()
+	q.checkDone()
+	return item
 }
 
 // Peek returns the item at the front of the queue without removing it. If the
@@ -122,10 +387,10 @@ func (q *ConcurrentQueue[T]) Peek() (T, error) {
 	defer q.mutex.RUnlock()
 
 	var zero T
-	if len(q.items) == 0 {
+	if q.items.len() == 0 {
 		return zero, ErrEmptyQueue
 	}
-	return q.items[0], nil
+	return q.items.front(), nil
 }
 
 // IsEmpty returns true if the queue is empty.
@@ -133,7 +398,7 @@ func (q *ConcurrentQueue[T]) IsEmpty() bool {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
 
-	return len(q.items) == 0
+	return q.items.len() == 0
 }
 
 // Size returns the number of items in the queue.
@@ -141,7 +406,45 @@ func (q *ConcurrentQueue[T]) Size() int {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
 
-	return len(q.items)
+	return q.items.len()
+}
+
+// Values returns an iterator over the items in the queue, from front to back,
+// without removing them. The iterator works on a snapshot of the queue taken
+// when iteration starts, so the lock is not held while the loop body runs and
+// changes made to the queue during iteration are not visible to the iterator.
+func (q *ConcurrentQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		q.mutex.RLock()
+		items := q.items.slice()
+		q.mutex.RUnlock()
+
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that dequeues items from the front of the queue as
+// they are consumed. Each item is dequeued under the lock, but the lock is not
+// held while the loop body runs, so items enqueued by other goroutines during
+// iteration are drained as well and other consumers may receive some of the
+// items. Iteration ends when the queue is empty. If the loop is exited early,
+// the items that were not consumed remain in the queue.
+func (q *ConcurrentQueue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			item, err := q.Dequeue()
+			if err != nil {
+				return
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
 }
 
 // String returns a string representation of the queue.
@@ -149,7 +452,15 @@ func (q *ConcurrentQueue[T]) String() string {
 	q.mutex.RLock()
 	defer q.mutex.RUnlock()
 
-	return fmt.Sprintf("%v", q.items)
+	return fmt.Sprintf("%v", q.items.slice())
+}
+
+// Contains returns true if the queue contains the given item.
+func (q *ConcurrentQueue[T]) Contains(item T) bool {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.items.contains(item, q.comparer)
 }
 
 // Clear removes all items from the queue.
@@ -157,7 +468,9 @@ func (q *ConcurrentQueue[T]) Clear() {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
 
-	q.items = []T{}
+	q.items.clear()
+	q.notFull.broadcast()
+	q.checkDone()
 }
 
 // CopyTo copies the items in the queue to the given slice, starting at the
@@ -171,11 +484,11 @@ func (q *ConcurrentQueue[T]) CopyTo(items []T, index int) error {
 		return ErrIndexOutOfRange
 	}
 
-	if len(items)-index < len(q.items) {
+	if len(items)-index < q.items.len() {
 		return ErrIndexOutOfRange
 	}
 
-	copy(items[index:], q.items)
+	q.items.copyTo(items[index:])
 
 	return nil
 }
@@ -1,8 +1,12 @@
 package collections
 
 import (
+	"context"
+	"fmt"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestConcurrentQueue_Clear(t *testing.T) {
@@ -18,14 +22,14 @@ func TestConcurrentQueue_Clear(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if len(tt.q.items) == 0 {
+			if tt.q.items.len() == 0 {
 				t.Error("Setup failed")
 			}
 
 			tt.q.Clear()
 
-			if len(tt.q.items) != 0 {
-				t.Errorf("Clear() got = %v, want %v", tt.q.items, []string{})
+			if tt.q.items.len() != 0 {
+				t.Errorf("Clear() got = %v, want %v", tt.q.items.slice(), []string{})
 			}
 		})
 	}
@@ -134,8 +138,8 @@ func TestConcurrentQueue_Enqueue(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.q.Enqueue(tt.args.item)
 
-			if len(tt.q.items) != len(tt.wants) {
-				t.Errorf("Enqueue() got = %v, want %v", tt.q.items, tt.wants)
+			if !reflect.DeepEqual(tt.q.items.slice(), tt.wants) {
+				t.Errorf("Enqueue() got = %v, want %v", tt.q.items.slice(), tt.wants)
 			}
 		})
 	}
@@ -264,18 +268,23 @@ func TestNewConcurrentQueue(t *testing.T) {
 	type testCase[T any] struct {
 		name string
 		args args[T]
-		want *ConcurrentQueue[T]
+		want []T
 	}
 	tests := []testCase[string]{
 		{
 			name: "Normal",
 			args: args[string]{values: []string{"A", "B", "C"}},
-			want: &ConcurrentQueue[string]{items: []string{"A", "B", "C"}},
+			want: []string{"A", "B", "C"},
+		},
+		{
+			name: "Empty",
+			args: args[string]{values: nil},
+			want: []string{},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewConcurrentQueue(tt.args.values...); !reflect.DeepEqual(got, tt.want) {
+			if got := NewConcurrentQueue(tt.args.values...); !reflect.DeepEqual(got.items.slice(), tt.want) {
 				t.Errorf("NewConcurrentQueue() = %v, want %v", got, tt.want)
 			}
 		})
@@ -289,18 +298,23 @@ func TestNewQueue(t *testing.T) {
 	type testCase[T any] struct {
 		name string
 		args args[T]
-		want *Queue[T]
+		want []T
 	}
 	tests := []testCase[string]{
 		{
 			name: "Normal",
 			args: args[string]{values: []string{"A", "B", "C"}},
-			want: &Queue[string]{items: []string{"A", "B", "C"}},
+			want: []string{"A", "B", "C"},
+		},
+		{
+			name: "Empty",
+			args: args[string]{values: nil},
+			want: []string{},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewQueue(tt.args.values...); !reflect.DeepEqual(got, tt.want) {
+			if got := NewQueue(tt.args.values...); !reflect.DeepEqual(got.items.slice(), tt.want) {
 				t.Errorf("NewQueue() = %v, want %v", got, tt.want)
 			}
 		})
@@ -320,13 +334,13 @@ func TestQueue_Clear(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if len(tt.q.items) == 0 {
+			if tt.q.items.len() == 0 {
 				t.Error("Setup failed")
 			}
 			tt.q.Clear()
 
-			if len(tt.q.items) != 0 {
-				t.Errorf("Clear() got = %v, want %v", tt.q.items, []string{})
+			if tt.q.items.len() != 0 {
+				t.Errorf("Clear() got = %v, want %v", tt.q.items.slice(), []string{})
 			}
 		})
 	}
@@ -435,8 +449,8 @@ func TestQueue_Enqueue(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.q.Enqueue(tt.args.item)
 
-			if !reflect.DeepEqual(tt.q.items, tt.wants) {
-				t.Errorf("Enqueue() got = %v, want %v", tt.q.items, tt.wants)
+			if !reflect.DeepEqual(tt.q.items.slice(), tt.wants) {
+				t.Errorf("Enqueue() got = %v, want %v", tt.q.items.slice(), tt.wants)
 			}
 		})
 	}
@@ -593,3 +607,553 @@ func BenchmarkQueue_Dequeue(b *testing.B) {
 		_, _ = q.Dequeue()
 	}
 }
+
+func TestQueue_Values(t *testing.T) {
+	q := NewQueue[string]("A", "B", "C")
+
+	var got []string
+	for v := range q.Values() {
+		got = append(got, v)
+	}
+	if want := []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if q.Size() != 3 {
+		t.Errorf("Values() removed items from the queue")
+	}
+}
+
+func TestQueue_Drain(t *testing.T) {
+	type testCase[T any] struct {
+		name     string
+		q        *Queue[T]
+		limit    int
+		want     []T
+		wantSize int
+	}
+	tests := []testCase[string]{
+		{
+			name:     "All",
+			q:        NewQueue[string]("A", "B", "C"),
+			limit:    10,
+			want:     []string{"A", "B", "C"},
+			wantSize: 0,
+		},
+		{
+			name:     "Break",
+			q:        NewQueue[string]("A", "B", "C"),
+			limit:    2,
+			want:     []string{"A", "B"},
+			wantSize: 1,
+		},
+		{
+			name:     "Empty",
+			q:        NewQueue[string](),
+			limit:    10,
+			want:     nil,
+			wantSize: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for v := range tt.q.Drain() {
+				got = append(got, v)
+				if len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Drain() = %v, want %v", got, tt.want)
+			}
+			if size := tt.q.Size(); size != tt.wantSize {
+				t.Errorf("Drain() left %v items, want %v", size, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestConcurrentQueue_Values(t *testing.T) {
+	q := NewConcurrentQueue[string]("A", "B", "C")
+
+	var got []string
+	for v := range q.Values() {
+		// The iterator works on a snapshot, so mutating the queue must neither
+		// deadlock nor change what is being iterated.
+		q.Enqueue(v)
+		got = append(got, v)
+	}
+	if want := []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if size := q.Size(); size != 6 {
+		t.Errorf("Size() = %v, want %v", size, 6)
+	}
+}
+
+func TestConcurrentQueue_Drain(t *testing.T) {
+	q := NewConcurrentQueue[int]()
+	for i := 0; i < 1000; i++ {
+		q.Enqueue(i)
+	}
+
+	var wg sync.WaitGroup
+	counts := make([]int, 4)
+	for w := range counts {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for range q.Drain() {
+				counts[w]++
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	if total != 1000 {
+		t.Errorf("Drain() consumed %v items, want %v", total, 1000)
+	}
+	if !q.IsEmpty() {
+		t.Errorf("Drain() left %v items", q.Size())
+	}
+}
+
+func ExampleQueue_Drain() {
+	queue := NewQueue[string]("A", "B", "C")
+	for item := range queue.Drain() {
+		fmt.Println(item)
+	}
+	fmt.Println(queue.Size())
+	// Output:
+	// A
+	// B
+	// C
+	// 0
+}
+
+func BenchmarkQueue_SteadyState(b *testing.B) {
+	q := NewQueue[int]()
+	for i := 0; i < 64; i++ {
+		q.Enqueue(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i)
+		_, _ = q.Dequeue()
+	}
+}
+
+func BenchmarkConcurrentQueue_SteadyState(b *testing.B) {
+	q := NewConcurrentQueue[int]()
+	for i := 0; i < 64; i++ {
+		q.Enqueue(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i)
+		_, _ = q.Dequeue()
+	}
+}
+
+func TestNewBoundedConcurrentQueue(t *testing.T) {
+	tests := []struct {
+		name      string
+		capacity  int
+		wantPanic bool
+	}{
+		{name: "Normal", capacity: 3, wantPanic: false},
+		{name: "Zero", capacity: 0, wantPanic: true},
+		{name: "Negative", capacity: -1, wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("NewBoundedConcurrentQueue() panic = %v, wantPanic %v", r, tt.wantPanic)
+				}
+			}()
+			q := NewBoundedConcurrentQueue[string](tt.capacity)
+			if got := q.Capacity(); got != tt.capacity {
+				t.Errorf("Capacity() = %v, want %v", got, tt.capacity)
+			}
+		})
+	}
+}
+
+func TestConcurrentQueue_TryEnqueue(t *testing.T) {
+	type testCase[T any] struct {
+		name    string
+		q       *ConcurrentQueue[T]
+		wantErr error
+	}
+	full := NewBoundedConcurrentQueue[string](2)
+	_ = full.TryEnqueue("A")
+	_ = full.TryEnqueue("B")
+	tests := []testCase[string]{
+		{
+			name:    "Unbounded",
+			q:       NewConcurrentQueue[string]("A", "B"),
+			wantErr: nil,
+		},
+		{
+			name:    "BoundedWithRoom",
+			q:       NewBoundedConcurrentQueue[string](2),
+			wantErr: nil,
+		},
+		{
+			name:    "BoundedFull",
+			q:       full,
+			wantErr: ErrQueueFull,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.q.TryEnqueue("C"); err != tt.wantErr {
+				t.Errorf("TryEnqueue() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConcurrentQueue_TryDequeue(t *testing.T) {
+	q := NewBoundedConcurrentQueue[string](1)
+	if _, err := q.TryDequeue(); err != ErrEmptyQueue {
+		t.Errorf("TryDequeue() error = %v, want %v", err, ErrEmptyQueue)
+	}
+	_ = q.TryEnqueue("A")
+	if got, err := q.TryDequeue(); got != "A" || err != nil {
+		t.Errorf("TryDequeue() = %v, %v, want %v, %v", got, err, "A", nil)
+	}
+}
+
+func TestConcurrentQueue_EnqueueContext(t *testing.T) {
+	q := NewBoundedConcurrentQueue[int](1)
+	if err := q.EnqueueContext(context.Background(), 1); err != nil {
+		t.Fatalf("EnqueueContext() error = %v", err)
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- q.EnqueueContext(context.Background(), 2)
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("EnqueueContext() returned %v on a full queue", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	if got, _ := q.Dequeue(); got != 1 {
+		t.Errorf("Dequeue() = %v, want %v", got, 1)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("EnqueueContext() error = %v", err)
+	}
+	if got, _ := q.Dequeue(); got != 2 {
+		t.Errorf("Dequeue() = %v, want %v", got, 2)
+	}
+}
+
+func TestConcurrentQueue_EnqueueContextCancelled(t *testing.T) {
+	q := NewBoundedConcurrentQueue[int](1)
+	_ = q.TryEnqueue(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- q.EnqueueContext(ctx, 2)
+	}()
+	cancel()
+
+	if err := <-done; err != context.Canceled {
+		t.Errorf("EnqueueContext() error = %v, want %v", err, context.Canceled)
+	}
+	if got := q.Size(); got != 1 {
+		t.Errorf("Size() = %v, want %v", got, 1)
+	}
+}
+
+func TestConcurrentQueue_DequeueContext(t *testing.T) {
+	q := NewBoundedConcurrentQueue[int](1)
+
+	type result struct {
+		item int
+		err  error
+	}
+	done := make(chan result)
+	go func() {
+		item, err := q.DequeueContext(context.Background())
+		done <- result{item, err}
+	}()
+
+	select {
+	case r := <-done:
+		t.Fatalf("DequeueContext() returned %v, %v on an empty queue", r.item, r.err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	q.Enqueue(1)
+	if r := <-done; r.item != 1 || r.err != nil {
+		t.Errorf("DequeueContext() = %v, %v, want %v, %v", r.item, r.err, 1, nil)
+	}
+}
+
+func TestConcurrentQueue_DequeueContextCancelled(t *testing.T) {
+	q := NewConcurrentQueue[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := q.DequeueContext(ctx); err != context.Canceled {
+		t.Errorf("DequeueContext() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestConcurrentQueue_Timeout(t *testing.T) {
+	q := NewBoundedConcurrentQueue[int](1)
+
+	if _, err := q.DequeueTimeout(time.Millisecond); err != context.DeadlineExceeded {
+		t.Errorf("DequeueTimeout() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if err := q.EnqueueTimeout(1, time.Millisecond); err != nil {
+		t.Errorf("EnqueueTimeout() error = %v", err)
+	}
+	if err := q.EnqueueTimeout(2, time.Millisecond); err != context.DeadlineExceeded {
+		t.Errorf("EnqueueTimeout() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got, err := q.DequeueTimeout(time.Millisecond); got != 1 || err != nil {
+		t.Errorf("DequeueTimeout() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+}
+
+func TestConcurrentQueue_BoundedProducersConsumers(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 250
+	q := NewBoundedConcurrentQueue[int](8)
+
+	var producing sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producing.Add(1)
+		go func(p int) {
+			defer producing.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := q.EnqueueContext(context.Background(), p*perProducer+i); err != nil {
+					t.Errorf("EnqueueContext() error = %v", err)
+				}
+			}
+		}(p)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results := make(chan int, producers*perProducer)
+	var consuming sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consuming.Add(1)
+		go func() {
+			defer consuming.Done()
+			for {
+				item, err := q.DequeueContext(ctx)
+				if err != nil {
+					return
+				}
+				if size := q.Size(); size > q.Capacity() {
+					t.Errorf("Size() = %v exceeds capacity %v", size, q.Capacity())
+				}
+				results <- item
+			}
+		}()
+	}
+
+	producing.Wait()
+	seen := make(map[int]bool)
+	for len(seen) < producers*perProducer {
+		item := <-results
+		if seen[item] {
+			t.Fatalf("item %v dequeued twice", item)
+		}
+		seen[item] = true
+	}
+	cancel()
+	consuming.Wait()
+}
+
+func ExampleNewBoundedConcurrentQueue() {
+	queue := NewBoundedConcurrentQueue[int](2)
+
+	go func() {
+		for i := 1; i <= 5; i++ {
+			// Blocks while the queue already holds two items
+			_ = queue.EnqueueContext(context.Background(), i)
+		}
+	}()
+
+	for i := 0; i < 5; i++ {
+		item, _ := queue.DequeueContext(context.Background())
+		fmt.Println(item)
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+	// 4
+	// 5
+}
+
+func TestConcurrentQueue_Close(t *testing.T) {
+	q := NewConcurrentQueue[string]("A", "B")
+	q.Close()
+	q.Close()
+
+	if !q.IsClosed() {
+		t.Errorf("IsClosed() = false, want true")
+	}
+	if err := q.Enqueue("C"); err != ErrClosed {
+		t.Errorf("Enqueue() error = %v, want %v", err, ErrClosed)
+	}
+	if err := q.TryEnqueue("C"); err != ErrClosed {
+		t.Errorf("TryEnqueue() error = %v, want %v", err, ErrClosed)
+	}
+
+	select {
+	case <-q.Done():
+		t.Fatalf("Done() fired while items remain")
+	default:
+	}
+
+	for _, want := range []string{"A", "B"} {
+		if got, err := q.Dequeue(); got != want || err != nil {
+			t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, want, nil)
+		}
+	}
+	if _, err := q.Dequeue(); err != ErrClosed {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrClosed)
+	}
+	if _, err := q.DequeueContext(context.Background()); err != ErrClosed {
+		t.Errorf("DequeueContext() error = %v, want %v", err, ErrClosed)
+	}
+
+	select {
+	case <-q.Done():
+	default:
+		t.Errorf("Done() did not fire once the queue was closed and empty")
+	}
+}
+
+func TestConcurrentQueue_CloseWakesConsumers(t *testing.T) {
+	q := NewConcurrentQueue[int]()
+
+	errs := make(chan error)
+	for i := 0; i < 3; i++ {
+		go func() {
+			_, err := q.DequeueContext(context.Background())
+			errs <- err
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	for i := 0; i < 3; i++ {
+		if err := <-errs; err != ErrClosed {
+			t.Errorf("DequeueContext() error = %v, want %v", err, ErrClosed)
+		}
+	}
+}
+
+func TestConcurrentQueue_CloseWakesProducers(t *testing.T) {
+	q := NewBoundedConcurrentQueue[int](1)
+	_ = q.Enqueue(1)
+
+	errs := make(chan error)
+	go func() {
+		errs <- q.EnqueueContext(context.Background(), 2)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	if err := <-errs; err != ErrClosed {
+		t.Errorf("EnqueueContext() error = %v, want %v", err, ErrClosed)
+	}
+	if got, err := q.Dequeue(); got != 1 || err != nil {
+		t.Errorf("Dequeue() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+}
+
+func TestConcurrentQueue_CloseDrain(t *testing.T) {
+	const items = 1000
+	q := NewBoundedConcurrentQueue[int](16)
+
+	var consumed sync.WaitGroup
+	var mutex sync.Mutex
+	total := 0
+	for c := 0; c < 4; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				_, err := q.DequeueContext(context.Background())
+				if err == ErrClosed {
+					return
+				}
+				if err != nil {
+					t.Errorf("DequeueContext() error = %v", err)
+					return
+				}
+				mutex.Lock()
+				total++
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for i := 0; i < items; i++ {
+		if err := q.Enqueue(i); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+	q.Close()
+
+	<-q.Done()
+	consumed.Wait()
+	if total != items {
+		t.Errorf("consumed %v items, want %v", total, items)
+	}
+}
+
+func ExampleConcurrentQueue_Close() {
+	queue := NewConcurrentQueue[int]()
+
+	go func() {
+		for i := 1; i <= 3; i++ {
+			_ = queue.Enqueue(i)
+		}
+		queue.Close()
+	}()
+
+	for {
+		item, err := queue.DequeueContext(context.Background())
+		if err == ErrClosed {
+			break
+		}
+		fmt.Println(item)
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+}
+
+func BenchmarkConcurrentQueue_Contention(b *testing.B) {
+	q := NewConcurrentQueue[int]()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			_ = q.Enqueue(i)
+			_, _ = q.Dequeue()
+		}
+	})
+}
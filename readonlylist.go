@@ -0,0 +1,60 @@
+package collections
+
+import (
+	"iter"
+)
+
+// readOnlyList wraps a list so that only its read-only methods can be called.
+// Because the wrapper holds the list rather than a copy of it, changes made to
+// the list are visible through the wrapper.
+type readOnlyList[T any] struct {
+	list ReadOnlyList[T]
+}
+
+// Size returns the number of items in the list.
+func (r *readOnlyList[T]) Size() int {
+	return r.list.Size()
+}
+
+// IsEmpty returns true if the list is empty.
+func (r *readOnlyList[T]) IsEmpty() bool {
+	return r.list.IsEmpty()
+}
+
+// Get returns the item at the given index.
+func (r *readOnlyList[T]) Get(index int) (T, error) {
+	return r.list.Get(index)
+}
+
+// IndexOf returns the index of the given item. If the item is not found, -1 is
+// returned.
+func (r *readOnlyList[T]) IndexOf(item T) int {
+	return r.list.IndexOf(item)
+}
+
+// Contains returns true if the list contains the given item.
+func (r *readOnlyList[T]) Contains(item T) bool {
+	return r.list.Contains(item)
+}
+
+// CopyTo copies the items in the list to the given slice, starting at the given
+// index.
+func (r *readOnlyList[T]) CopyTo(items []T, index int) error {
+	return r.list.CopyTo(items, index)
+}
+
+// All returns an iterator over the indexes and items in the list, from first
+// to last.
+func (r *readOnlyList[T]) All() iter.Seq2[int, T] {
+	return r.list.All()
+}
+
+// Values returns an iterator over the items in the list, from first to last.
+func (r *readOnlyList[T]) Values() iter.Seq[T] {
+	return r.list.Values()
+}
+
+// String returns a string representation of the list.
+func (r *readOnlyList[T]) String() string {
+	return r.list.String()
+}
@@ -0,0 +1,207 @@
+package collections
+
+// minRingCapacity is the smallest non-zero capacity of a ring buffer. A ring
+// buffer never shrinks below this capacity.
+const minRingCapacity = 8
+
+// ring implements a growable circular buffer whose capacity is always zero or
+// a power of two. It grows by doubling when it is full and shrinks by halving
+// when it is at most a quarter full, so that alternating adds and removes at
+// the boundary do not cause repeated reallocation. Vacated slots are zeroed so
+// that the items they held can be garbage collected.
+type ring[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// newRing returns a ring buffer containing a copy of the given items.
+func newRing[T any](values []T) ring[T] {
+	r := ring[T]{}
+	if len(values) == 0 {
+		return r
+	}
+
+	capacity := minRingCapacity
+	for capacity < len(values) {
+		capacity <<= 1
+	}
+	r.buf = make([]T, capacity)
+	r.count = copy(r.buf, values)
+	return r
+}
+
+// len returns the number of items in the ring buffer.
+func (r *ring[T]) len() int {
+	return r.count
+}
+
+// index returns the position in buf of the i-th item.
+func (r *ring[T]) index(i int) int {
+	return (r.head + i) & (len(r.buf) - 1)
+}
+
+// at returns the i-th item. The index must be in range.
+func (r *ring[T]) at(i int) T {
+	return r.buf[r.index(i)]
+}
+
+// set replaces the i-th item. The index must be in range.
+func (r *ring[T]) set(i int, item T) {
+	r.buf[r.index(i)] = item
+}
+
+// front returns the first item. The ring buffer must not be empty.
+func (r *ring[T]) front() T {
+	return r.buf[r.head]
+}
+
+// back returns the last item. The ring buffer must not be empty.
+func (r *ring[T]) back() T {
+	return r.buf[r.index(r.count-1)]
+}
+
+// pushFront adds an item before the first item.
+func (r *ring[T]) pushFront(item T) {
+	if r.count == len(r.buf) {
+		r.grow()
+	}
+	r.head = (r.head - 1) & (len(r.buf) - 1)
+	r.buf[r.head] = item
+	r.count++
+}
+
+// pushBack adds an item after the last item.
+func (r *ring[T]) pushBack(item T) {
+	if r.count == len(r.buf) {
+		r.grow()
+	}
+	r.buf[r.index(r.count)] = item
+	r.count++
+}
+
+// popFront removes and returns the first item. The ring buffer must not be
+// empty.
+func (r *ring[T]) popFront() T {
+	var zero T
+	item := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) & (len(r.buf) - 1)
+	r.count--
+	r.shrink()
+	return item
+}
+
+// popBack removes and returns the last item. The ring buffer must not be
+// empty.
+func (r *ring[T]) popBack() T {
+	var zero T
+	i := r.index(r.count - 1)
+	item := r.buf[i]
+	r.buf[i] = zero
+	r.count--
+	r.shrink()
+	return item
+}
+
+// rotate moves the first n items to the back, so that the item at index n
+// becomes the first item. A negative n moves the last -n items to the front.
+func (r *ring[T]) rotate(n int) {
+	if r.count == 0 {
+		return
+	}
+	n %= r.count
+	if n < 0 {
+		n += r.count
+	}
+	if n == 0 {
+		return
+	}
+
+	if r.count == len(r.buf) {
+		r.head = r.index(n)
+		return
+	}
+
+	mask := len(r.buf) - 1
+	var zero T
+	if n <= r.count/2 {
+		for ; n > 0; n-- {
+			r.buf[(r.head+r.count)&mask] = r.buf[r.head]
+			r.buf[r.head] = zero
+			r.head = (r.head + 1) & mask
+		}
+		return
+	}
+	for n = r.count - n; n > 0; n-- {
+		r.head = (r.head - 1) & mask
+		last := (r.head + r.count) & mask
+		r.buf[r.head] = r.buf[last]
+		r.buf[last] = zero
+	}
+}
+
+// contains returns true if the ring buffer contains the given item, according
+// to the given comparer.
+func (r *ring[T]) contains(item T, comparer EqualityComparer[T]) bool {
+	for i := 0; i < r.count; i++ {
+		if comparer(r.at(i), item) {
+			return true
+		}
+	}
+	return false
+}
+
+// clear removes all items and releases the buffer.
+func (r *ring[T]) clear() {
+	*r = ring[T]{}
+}
+
+// copyTo copies the items, from first to last, to the given slice. The slice
+// must be large enough to hold all the items.
+func (r *ring[T]) copyTo(dst []T) {
+	if r.count == 0 {
+		return
+	}
+
+	end := r.head + r.count
+	if end <= len(r.buf) {
+		copy(dst, r.buf[r.head:end])
+		return
+	}
+
+	n := copy(dst, r.buf[r.head:])
+	copy(dst[n:], r.buf[:end-len(r.buf)])
+}
+
+// slice returns a copy of the items, from first to last.
+func (r *ring[T]) slice() []T {
+	items := make([]T, r.count)
+	r.copyTo(items)
+	return items
+}
+
+// grow doubles the capacity of the ring buffer.
+func (r *ring[T]) grow() {
+	capacity := len(r.buf) << 1
+	if capacity < minRingCapacity {
+		capacity = minRingCapacity
+	}
+	r.resize(capacity)
+}
+
+// shrink halves the capacity of the ring buffer if it is at most a quarter
+// full.
+func (r *ring[T]) shrink() {
+	if len(r.buf) > minRingCapacity && r.count <= len(r.buf)>>2 {
+		r.resize(len(r.buf) >> 1)
+	}
+}
+
+// resize moves the items into a new buffer with the given capacity.
+func (r *ring[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	r.copyTo(buf)
+	r.buf = buf
+	r.head = 0
+}
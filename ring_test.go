@@ -0,0 +1,180 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewRing(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		wantCap  int
+		wantLen  int
+		wantData []int
+	}{
+		{name: "Empty", values: nil, wantCap: 0, wantLen: 0, wantData: []int{}},
+		{name: "Small", values: []int{1, 2, 3}, wantCap: minRingCapacity, wantLen: 3, wantData: []int{1, 2, 3}},
+		{name: "Large", values: make([]int, 9), wantCap: 16, wantLen: 9, wantData: make([]int, 9)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRing(tt.values)
+			if len(r.buf) != tt.wantCap {
+				t.Errorf("newRing() capacity = %v, want %v", len(r.buf), tt.wantCap)
+			}
+			if r.len() != tt.wantLen {
+				t.Errorf("newRing() len = %v, want %v", r.len(), tt.wantLen)
+			}
+			if got := r.slice(); !reflect.DeepEqual(got, tt.wantData) {
+				t.Errorf("newRing() = %v, want %v", got, tt.wantData)
+			}
+		})
+	}
+}
+
+func TestNewRing_CopiesValues(t *testing.T) {
+	values := []int{1, 2, 3}
+	r := newRing(values)
+	values[0] = 10
+	if got := r.front(); got != 1 {
+		t.Errorf("newRing() shares its buffer with the given values, front = %v", got)
+	}
+}
+
+func TestRing_Wraparound(t *testing.T) {
+	r := newRing([]int{0, 1, 2, 3, 4, 5})
+	for i := 0; i < 4; i++ {
+		if got := r.popFront(); got != i {
+			t.Fatalf("popFront() = %v, want %v", got, i)
+		}
+	}
+	for i := 6; i < 12; i++ {
+		r.pushBack(i)
+	}
+
+	if len(r.buf) != minRingCapacity {
+		t.Errorf("capacity = %v, want %v", len(r.buf), minRingCapacity)
+	}
+	if r.head+r.len() <= len(r.buf) {
+		t.Fatalf("setup did not wrap around, head = %v, len = %v", r.head, r.len())
+	}
+	if got, want := r.slice(), []int{4, 5, 6, 7, 8, 9, 10, 11}; !reflect.DeepEqual(got, want) {
+		t.Errorf("slice() = %v, want %v", got, want)
+	}
+	for i := 0; i < r.len(); i++ {
+		if got := r.at(i); got != i+4 {
+			t.Errorf("at(%d) = %v, want %v", i, got, i+4)
+		}
+	}
+
+	// Growing a wrapped buffer must keep the items in order.
+	r.pushBack(12)
+	if len(r.buf) != 2*minRingCapacity {
+		t.Errorf("capacity = %v, want %v", len(r.buf), 2*minRingCapacity)
+	}
+	if got, want := r.slice(), []int{4, 5, 6, 7, 8, 9, 10, 11, 12}; !reflect.DeepEqual(got, want) {
+		t.Errorf("slice() = %v, want %v", got, want)
+	}
+}
+
+func TestRing_Shrink(t *testing.T) {
+	r := ring[int]{}
+	for i := 0; i < 64; i++ {
+		r.pushBack(i)
+	}
+	if len(r.buf) != 64 {
+		t.Fatalf("capacity = %v, want %v", len(r.buf), 64)
+	}
+
+	for i := 0; i < 48; i++ {
+		r.popFront()
+	}
+	if len(r.buf) != 32 {
+		t.Errorf("capacity = %v, want %v", len(r.buf), 32)
+	}
+
+	for r.len() > 0 {
+		r.popFront()
+	}
+	if len(r.buf) != minRingCapacity {
+		t.Errorf("capacity = %v, want %v", len(r.buf), minRingCapacity)
+	}
+}
+
+func TestRing_PopFrontZeroesSlot(t *testing.T) {
+	a, b := new(int), new(int)
+	r := newRing([]*int{a, b})
+	if got := r.popFront(); got != a {
+		t.Errorf("popFront() = %v, want %v", got, a)
+	}
+	for i, v := range r.buf {
+		if v == a {
+			t.Errorf("popFront() left the item in slot %d", i)
+		}
+	}
+}
+
+func TestRing_Clear(t *testing.T) {
+	r := newRing([]int{1, 2, 3})
+	r.clear()
+	if r.len() != 0 || r.buf != nil {
+		t.Errorf("clear() did not release the buffer, len = %v, capacity = %v", r.len(), len(r.buf))
+	}
+	r.pushBack(4)
+	if got := r.slice(); !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("slice() = %v, want %v", got, []int{4})
+	}
+}
+
+func TestRing_PushFrontPopBack(t *testing.T) {
+	r := ring[int]{}
+	for i := 0; i < 10; i++ {
+		r.pushFront(i)
+	}
+	if got, want := r.slice(), []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("slice() = %v, want %v", got, want)
+	}
+	if got := r.back(); got != 0 {
+		t.Errorf("back() = %v, want %v", got, 0)
+	}
+	for i := 0; i < 10; i++ {
+		if got := r.popBack(); got != i {
+			t.Errorf("popBack() = %v, want %v", got, i)
+		}
+	}
+	if len(r.buf) != minRingCapacity {
+		t.Errorf("capacity = %v, want %v", len(r.buf), minRingCapacity)
+	}
+}
+
+func TestRing_Rotate(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		n      int
+		want   []int
+	}{
+		{name: "Forward", values: []int{1, 2, 3, 4, 5}, n: 2, want: []int{3, 4, 5, 1, 2}},
+		{name: "ForwardPastHalf", values: []int{1, 2, 3, 4, 5}, n: 4, want: []int{5, 1, 2, 3, 4}},
+		{name: "Backward", values: []int{1, 2, 3, 4, 5}, n: -1, want: []int{5, 1, 2, 3, 4}},
+		{name: "Wrap", values: []int{1, 2, 3}, n: 7, want: []int{2, 3, 1}},
+		{name: "Zero", values: []int{1, 2, 3}, n: 0, want: []int{1, 2, 3}},
+		{name: "Full", values: []int{1, 2, 3, 4, 5, 6, 7, 8}, n: 3, want: []int{4, 5, 6, 7, 8, 1, 2, 3}},
+		{name: "Empty", values: nil, n: 3, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRing(tt.values)
+			r.rotate(tt.n)
+			if got := r.slice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rotate() = %v, want %v", got, tt.want)
+			}
+			for i := r.len(); i < len(r.buf); i++ {
+				if r.buf[r.index(i)] != 0 {
+					t.Errorf("rotate() left a stale item in an unused slot: %v", r.buf)
+				}
+			}
+		})
+	}
+}
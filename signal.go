@@ -0,0 +1,26 @@
+package collections
+
+// signal wakes every goroutine that is waiting for a change in state. Unlike
+// sync.Cond it can be used in a select statement, so that waiting can be
+// combined with a context. A signal is not thread-safe; it must be guarded by
+// the lock that guards the state it reports on.
+type signal struct {
+	ch chan struct{}
+}
+
+// wait returns a channel that is closed the next time broadcast is called.
+func (s *signal) wait() <-chan struct{} {
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	return s.ch
+}
+
+// broadcast wakes every goroutine that is waiting on the channel returned by
+// wait.
+func (s *signal) broadcast() {
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+}
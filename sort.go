@@ -0,0 +1,40 @@
+package collections
+
+// comparerSorter adapts a slice and a Comparer to sort.Interface so that a
+// list can be sorted in place without copying its items.
+type comparerSorter[T any] struct {
+	items    []T
+	comparer Comparer[T]
+}
+
+func (s comparerSorter[T]) Len() int {
+	return len(s.items)
+}
+
+func (s comparerSorter[T]) Less(i, j int) bool {
+	return s.comparer(s.items[i], s.items[j]) < 0
+}
+
+func (s comparerSorter[T]) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+}
+
+// binarySearch searches the sorted slice for the given item. If the item is
+// found, its index is returned, otherwise the bitwise complement of the index
+// at which the item would be inserted is returned.
+func binarySearch[T any](items []T, item T, comparer Comparer[T]) int {
+	lo, hi := 0, len(items)-1
+	for lo <= hi {
+		mid := int(uint(lo+hi) >> 1)
+		c := comparer(items[mid], item)
+		switch {
+		case c == 0:
+			return mid
+		case c < 0:
+			lo = mid + 1
+		default:
+			hi = mid - 1
+		}
+	}
+	return ^lo
+}
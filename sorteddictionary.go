@@ -0,0 +1,227 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// SortedDictionary implements a collection of keys and values in which each
+// key appears at most once and the entries are kept in ascending order of
+// their keys, as determined by a comparer. It is modelled on .NET's
+// SortedDictionary<TKey, TValue>, and also answers ordered queries such as
+// Floor, Ceiling and Select. The entries are stored in a balanced binary tree,
+// so lookups, insertions, removals and ordered queries take O(log n) time. It
+// is not thread-safe.
+type SortedDictionary[K any, V any] struct {
+	tree sortedTree[K, V]
+}
+
+// NewSortedDictionary returns a new, empty dictionary that orders its keys
+// using the given comparer.
+func NewSortedDictionary[K any, V any](comparer Comparer[K]) *SortedDictionary[K, V] {
+	return &SortedDictionary[K, V]{tree: sortedTree[K, V]{comparer: comparer}}
+}
+
+// Add adds the given key and value to the dictionary. If the key is already in
+// the dictionary, ErrDuplicateKey is returned and the dictionary is not
+// changed.
+func (d *SortedDictionary[K, V]) Add(key K, value V) error {
+	if !d.tree.put(key, value, false) {
+		return ErrDuplicateKey
+	}
+	return nil
+}
+
+// Set sets the value for the given key, adding the key if it is not already in
+// the dictionary.
+func (d *SortedDictionary[K, V]) Set(key K, value V) {
+	d.tree.put(key, value, true)
+}
+
+// TryGet returns the value for the given key and true. If the key is not
+// found, the zero value and false are returned.
+func (d *SortedDictionary[K, V]) TryGet(key K) (V, bool) {
+	n := d.tree.get(key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Get returns the value for the given key. If the key is not found,
+// ErrKeyNotFound is returned.
+func (d *SortedDictionary[K, V]) Get(key K) (V, error) {
+	value, ok := d.TryGet(key)
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+// Remove removes the given key and its value from the dictionary. If the key
+// is not found, false is returned, otherwise true is returned.
+func (d *SortedDictionary[K, V]) Remove(key K) bool {
+	return d.tree.remove(key)
+}
+
+// ContainsKey returns true if the dictionary contains the given key.
+func (d *SortedDictionary[K, V]) ContainsKey(key K) bool {
+	return d.tree.get(key) != nil
+}
+
+// Min returns the smallest key and its value. If the dictionary is empty,
+// false is returned.
+func (d *SortedDictionary[K, V]) Min() (K, V, bool) {
+	return sortedEntry(d.tree.first())
+}
+
+// Max returns the largest key and its value. If the dictionary is empty, false
+// is returned.
+func (d *SortedDictionary[K, V]) Max() (K, V, bool) {
+	return sortedEntry(d.tree.last())
+}
+
+// Floor returns the largest key that is less than or equal to the given key,
+// and its value. If there is no such key, false is returned.
+func (d *SortedDictionary[K, V]) Floor(key K) (K, V, bool) {
+	return sortedEntry(d.tree.floor(key, false))
+}
+
+// Ceiling returns the smallest key that is greater than or equal to the given
+// key, and its value. If there is no such key, false is returned.
+func (d *SortedDictionary[K, V]) Ceiling(key K) (K, V, bool) {
+	return sortedEntry(d.tree.ceiling(key, false))
+}
+
+// Predecessor returns the largest key that is less than the given key, and its
+// value. If there is no such key, false is returned.
+func (d *SortedDictionary[K, V]) Predecessor(key K) (K, V, bool) {
+	return sortedEntry(d.tree.floor(key, true))
+}
+
+// Successor returns the smallest key that is greater than the given key, and
+// its value. If there is no such key, false is returned.
+func (d *SortedDictionary[K, V]) Successor(key K) (K, V, bool) {
+	return sortedEntry(d.tree.ceiling(key, true))
+}
+
+// RankOf returns the number of keys in the dictionary that are less than the
+// given key. If the key is in the dictionary, this is its index in ascending
+// order.
+func (d *SortedDictionary[K, V]) RankOf(key K) int {
+	return d.tree.rank(key, false)
+}
+
+// Select returns the key with the given rank, counting from zero in ascending
+// order, and its value. If the rank is out of range, an error is returned.
+func (d *SortedDictionary[K, V]) Select(rank int) (K, V, error) {
+	if rank < 0 || rank >= d.tree.len() {
+		var key K
+		var value V
+		return key, value, ErrIndexOutOfRange
+	}
+	n := d.tree.at(rank)
+	return n.key, n.value, nil
+}
+
+// Range returns an iterator over the keys and values whose keys are between lo
+// and hi, inclusive, in ascending order. The dictionary should not be modified
+// during iteration.
+func (d *SortedDictionary[K, V]) Range(lo K, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		d.tree.ascend(d.tree.root, &lo, &hi, func(n *sortedTreeNode[K, V]) bool {
+			return yield(n.key, n.value)
+		})
+	}
+}
+
+// All returns an iterator over the keys and values in the dictionary, in
+// ascending order of their keys. The dictionary should not be modified during
+// iteration.
+func (d *SortedDictionary[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		d.tree.ascend(d.tree.root, nil, nil, func(n *sortedTreeNode[K, V]) bool {
+			return yield(n.key, n.value)
+		})
+	}
+}
+
+// Backward returns an iterator over the keys and values in the dictionary, in
+// descending order of their keys. The dictionary should not be modified during
+// iteration.
+func (d *SortedDictionary[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		d.tree.descend(d.tree.root, nil, nil, func(n *sortedTreeNode[K, V]) bool {
+			return yield(n.key, n.value)
+		})
+	}
+}
+
+// Keys returns an iterator over the keys in the dictionary, in ascending order.
+// The dictionary should not be modified during iteration.
+func (d *SortedDictionary[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range d.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in the dictionary, in ascending
+// order of their keys. The dictionary should not be modified during iteration.
+func (d *SortedDictionary[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range d.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of keys in the dictionary.
+func (d *SortedDictionary[K, V]) Size() int {
+	return d.tree.len()
+}
+
+// IsEmpty returns true if the dictionary is empty.
+func (d *SortedDictionary[K, V]) IsEmpty() bool {
+	return d.tree.len() == 0
+}
+
+// Clear removes all keys and values from the dictionary.
+func (d *SortedDictionary[K, V]) Clear() {
+	d.tree.clear()
+}
+
+// String returns a string representation of the dictionary, in the same format
+// as a Go map.
+func (d *SortedDictionary[K, V]) String() string {
+	var b strings.Builder
+	b.WriteString("map[")
+	first := true
+	for k, v := range d.All() {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprintf(&b, "%v:%v", k, v)
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// sortedEntry returns the key and value of the given node and true, or false
+// if the node is nil.
+func sortedEntry[K any, V any](n *sortedTreeNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
@@ -0,0 +1,182 @@
+package collections
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// checkSortedTree fails the test if the tree is not a valid AVL tree ordered
+// by its comparer.
+func checkSortedTree[K any, V any](t *testing.T, tree *sortedTree[K, V]) {
+	t.Helper()
+
+	var check func(n *sortedTreeNode[K, V]) (height, size int)
+	check = func(n *sortedTreeNode[K, V]) (int, int) {
+		if n == nil {
+			return 0, 0
+		}
+		if n.left != nil && tree.comparer(n.left.key, n.key) >= 0 {
+			t.Fatalf("left child %v is not less than %v", n.left.key, n.key)
+		}
+		if n.right != nil && tree.comparer(n.right.key, n.key) <= 0 {
+			t.Fatalf("right child %v is not greater than %v", n.right.key, n.key)
+		}
+		lh, ls := check(n.left)
+		rh, rs := check(n.right)
+		if lh-rh > 1 || rh-lh > 1 {
+			t.Fatalf("node %v is unbalanced: left height %v, right height %v", n.key, lh, rh)
+		}
+		if n.height != 1+max(lh, rh) || n.size != 1+ls+rs {
+			t.Fatalf("node %v has height %v and size %v, want %v and %v", n.key, n.height, n.size, 1+max(lh, rh), 1+ls+rs)
+		}
+		return n.height, n.size
+	}
+	check(tree.root)
+}
+
+func TestSortedDictionary_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := NewSortedDictionary[int, int](compareInts)
+	want := map[int]int{}
+
+	for i := 0; i < 3000; i++ {
+		key := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, ok := want[key]
+			if got := d.Remove(key); got != ok {
+				t.Fatalf("Remove(%v) = %v, want %v", key, got, ok)
+			}
+			delete(want, key)
+		} else {
+			d.Set(key, i)
+			want[key] = i
+		}
+	}
+	checkSortedTree(t, &d.tree)
+
+	keys := make([]int, 0, len(want))
+	for k := range want {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	if got := slices.Collect(d.Keys()); !slices.Equal(got, keys) {
+		t.Fatalf("Keys() = %v, want %v", got, keys)
+	}
+	for i, k := range keys {
+		if v, _ := d.Get(k); v != want[k] {
+			t.Errorf("Get(%v) = %v, want %v", k, v, want[k])
+		}
+		if got := d.RankOf(k); got != i {
+			t.Errorf("RankOf(%v) = %v, want %v", k, got, i)
+		}
+		if got, _, err := d.Select(i); got != k || err != nil {
+			t.Errorf("Select(%v) = %v, %v, want %v, %v", i, got, err, k, nil)
+		}
+	}
+	if got := d.Size(); got != len(keys) {
+		t.Errorf("Size() = %v, want %v", got, len(keys))
+	}
+}
+
+func TestSortedDictionary_Add(t *testing.T) {
+	d := NewSortedDictionary[string, int](strings.Compare)
+	_ = d.Add("b", 2)
+	_ = d.Add("a", 1)
+	if err := d.Add("a", 3); err != ErrDuplicateKey {
+		t.Errorf("Add() error = %v, want %v", err, ErrDuplicateKey)
+	}
+	if got, err := d.Get("a"); got != 1 || err != nil {
+		t.Errorf("Get() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if _, err := d.Get("c"); err != ErrKeyNotFound {
+		t.Errorf("Get() error = %v, want %v", err, ErrKeyNotFound)
+	}
+	if _, ok := d.TryGet("c"); ok || !d.ContainsKey("b") {
+		t.Errorf("TryGet() or ContainsKey() does not match the dictionary")
+	}
+	if got := d.String(); got != "map[a:1 b:2]" {
+		t.Errorf("String() = %v, want %v", got, "map[a:1 b:2]")
+	}
+
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Errorf("Clear() left %v keys", d.Size())
+	}
+}
+
+func TestSortedDictionary_Queries(t *testing.T) {
+	d := NewSortedDictionary[int, string](compareInts)
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		d.Set(k, strings.Repeat("x", k/10))
+	}
+
+	tests := []struct {
+		name   string
+		query  func(int) (int, string, bool)
+		key    int
+		want   int
+		wantOk bool
+	}{
+		{name: "Floor/Equal", query: d.Floor, key: 30, want: 30, wantOk: true},
+		{name: "Floor/Between", query: d.Floor, key: 35, want: 30, wantOk: true},
+		{name: "Floor/Below", query: d.Floor, key: 5, wantOk: false},
+		{name: "Ceiling/Equal", query: d.Ceiling, key: 30, want: 30, wantOk: true},
+		{name: "Ceiling/Between", query: d.Ceiling, key: 35, want: 40, wantOk: true},
+		{name: "Ceiling/Above", query: d.Ceiling, key: 55, wantOk: false},
+		{name: "Predecessor/Equal", query: d.Predecessor, key: 30, want: 20, wantOk: true},
+		{name: "Predecessor/First", query: d.Predecessor, key: 10, wantOk: false},
+		{name: "Successor/Equal", query: d.Successor, key: 30, want: 40, wantOk: true},
+		{name: "Successor/Last", query: d.Successor, key: 50, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, ok := tt.query(tt.key)
+			if ok != tt.wantOk || (ok && got != tt.want) {
+				t.Errorf("got %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	if k, v, ok := d.Min(); k != 10 || v != "x" || !ok {
+		t.Errorf("Min() = %v, %v, %v", k, v, ok)
+	}
+	if k, _, ok := d.Max(); k != 50 || !ok {
+		t.Errorf("Max() = %v, %v", k, ok)
+	}
+	if got := d.RankOf(35); got != 3 {
+		t.Errorf("RankOf(35) = %v, want %v", got, 3)
+	}
+	if _, _, err := d.Select(5); err != ErrIndexOutOfRange {
+		t.Errorf("Select(5) error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	var keys []int
+	for k := range d.Range(15, 40) {
+		keys = append(keys, k)
+	}
+	if want := []int{20, 30, 40}; !slices.Equal(keys, want) {
+		t.Errorf("Range() = %v, want %v", keys, want)
+	}
+
+	keys = keys[:0]
+	for k := range d.Backward() {
+		keys = append(keys, k)
+		if k == 30 {
+			break
+		}
+	}
+	if want := []int{50, 40, 30}; !slices.Equal(keys, want) {
+		t.Errorf("Backward() = %v, want %v", keys, want)
+	}
+	if got := slices.Collect(d.Values()); !slices.Equal(got, []string{"x", "xx", "xxx", "xxxx", "xxxxx"}) {
+		t.Errorf("Values() = %v", got)
+	}
+
+	empty := NewSortedDictionary[int, string](compareInts)
+	if _, _, ok := empty.Min(); ok {
+		t.Errorf("Min() of an empty dictionary = true, want false")
+	}
+}
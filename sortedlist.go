@@ -0,0 +1,227 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// SortedList implements a collection of keys and values in which each key
+// appears at most once and the entries are kept in ascending order of their
+// keys, as determined by a comparer. It is modelled on .NET's
+// SortedList<TKey, TValue>. The keys and values are stored in two parallel
+// slices, so it uses less memory than SortedDictionary and entries can be read
+// by index. Lookups take O(log n) time, but inserting or removing anywhere but
+// the end takes O(n) time. It is not thread-safe.
+type SortedList[K any, V any] struct {
+	keys     []K
+	values   []V
+	comparer Comparer[K]
+}
+
+// NewSortedList returns a new, empty list that orders its keys using the given
+// comparer.
+func NewSortedList[K any, V any](comparer Comparer[K]) *SortedList[K, V] {
+	return &SortedList[K, V]{comparer: comparer}
+}
+
+// Add adds the given key and value to the list. If the key is already in the
+// list, ErrDuplicateKey is returned and the list is not changed.
+func (l *SortedList[K, V]) Add(key K, value V) error {
+	i := binarySearch(l.keys, key, l.comparer)
+	if i >= 0 {
+		return ErrDuplicateKey
+	}
+	l.insert(^i, key, value)
+	return nil
+}
+
+// Set sets the value for the given key, adding the key if it is not already in
+// the list.
+func (l *SortedList[K, V]) Set(key K, value V) {
+	i := binarySearch(l.keys, key, l.comparer)
+	if i >= 0 {
+		l.values[i] = value
+		return
+	}
+	l.insert(^i, key, value)
+}
+
+// TryGet returns the value for the given key and true. If the key is not
+// found, the zero value and false are returned.
+func (l *SortedList[K, V]) TryGet(key K) (V, bool) {
+	i := l.IndexOfKey(key)
+	if i == -1 {
+		var zero V
+		return zero, false
+	}
+	return l.values[i], true
+}
+
+// Get returns the value for the given key. If the key is not found,
+// ErrKeyNotFound is returned.
+func (l *SortedList[K, V]) Get(key K) (V, error) {
+	value, ok := l.TryGet(key)
+	if !ok {
+		return value, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+// Remove removes the given key and its value from the list. If the key is not
+// found, false is returned, otherwise true is returned.
+func (l *SortedList[K, V]) Remove(key K) bool {
+	i := l.IndexOfKey(key)
+	if i == -1 {
+		return false
+	}
+	return l.RemoveAt(i) == nil
+}
+
+// ContainsKey returns true if the list contains the given key.
+func (l *SortedList[K, V]) ContainsKey(key K) bool {
+	return l.IndexOfKey(key) != -1
+}
+
+// IndexOfKey returns the index of the given key, found by binary search. If
+// the key is not found, -1 is returned.
+func (l *SortedList[K, V]) IndexOfKey(key K) int {
+	i := binarySearch(l.keys, key, l.comparer)
+	if i < 0 {
+		return -1
+	}
+	return i
+}
+
+// GetKeyAt returns the key at the given index. If the index is out of range,
+// an error is returned.
+func (l *SortedList[K, V]) GetKeyAt(index int) (K, error) {
+	if index < 0 || index >= len(l.keys) {
+		var zero K
+		return zero, ErrIndexOutOfRange
+	}
+	return l.keys[index], nil
+}
+
+// GetValueAt returns the value at the given index. If the index is out of
+// range, an error is returned.
+func (l *SortedList[K, V]) GetValueAt(index int) (V, error) {
+	if index < 0 || index >= len(l.values) {
+		var zero V
+		return zero, ErrIndexOutOfRange
+	}
+	return l.values[index], nil
+}
+
+// SetValueAt sets the value at the given index. If the index is out of range,
+// an error is returned.
+func (l *SortedList[K, V]) SetValueAt(index int, value V) error {
+	if index < 0 || index >= len(l.values) {
+		return ErrIndexOutOfRange
+	}
+	l.values[index] = value
+	return nil
+}
+
+// RemoveAt removes the key and value at the given index. If the index is out
+// of range, an error is returned.
+func (l *SortedList[K, V]) RemoveAt(index int) error {
+	if index < 0 || index >= len(l.keys) {
+		return ErrIndexOutOfRange
+	}
+	l.keys = removeRange(l.keys, index, 1)
+	l.values = removeRange(l.values, index, 1)
+	return nil
+}
+
+// Range returns an iterator over the keys and values whose keys are between lo
+// and hi, inclusive, in ascending order. Changes made to the list during
+// iteration are visible to the iterator.
+func (l *SortedList[K, V]) Range(lo K, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		i := binarySearch(l.keys, lo, l.comparer)
+		if i < 0 {
+			i = ^i
+		}
+		for ; i < len(l.keys) && l.comparer(l.keys[i], hi) <= 0; i++ {
+			if !yield(l.keys[i], l.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys in the list, in ascending order.
+// Changes made to the list during iteration are visible to the iterator.
+func (l *SortedList[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for i := 0; i < len(l.keys); i++ {
+			if !yield(l.keys[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in the list, in ascending order
+// of their keys. Changes made to the list during iteration are visible to the
+// iterator.
+func (l *SortedList[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for i := 0; i < len(l.values); i++ {
+			if !yield(l.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the keys and values in the list, in ascending
+// order of their keys. Changes made to the list during iteration are visible
+// to the iterator.
+func (l *SortedList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := 0; i < len(l.keys); i++ {
+			if !yield(l.keys[i], l.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of keys in the list.
+func (l *SortedList[K, V]) Size() int {
+	return len(l.keys)
+}
+
+// IsEmpty returns true if the list is empty.
+func (l *SortedList[K, V]) IsEmpty() bool {
+	return len(l.keys) == 0
+}
+
+// Clear removes all keys and values from the list.
+func (l *SortedList[K, V]) Clear() {
+	l.keys = nil
+	l.values = nil
+}
+
+// String returns a string representation of the list, in the same format as a
+// Go map.
+func (l *SortedList[K, V]) String() string {
+	var b strings.Builder
+	b.WriteString("map[")
+	for i := range l.keys {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%v:%v", l.keys[i], l.values[i])
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// insert inserts the given key and value at the given index.
+func (l *SortedList[K, V]) insert(index int, key K, value V) {
+	l.keys = insertRange(l.keys, index, []K{key})
+	l.values = insertRange(l.values, index, []V{value})
+}
@@ -0,0 +1,164 @@
+package collections
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSortedList_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	l := NewSortedList[int, int](compareInts)
+	d := NewSortedDictionary[int, int](compareInts)
+
+	for i := 0; i < 2000; i++ {
+		key := r.Intn(200)
+		switch r.Intn(3) {
+		case 0:
+			if got, want := l.Remove(key), d.Remove(key); got != want {
+				t.Fatalf("Remove(%v) = %v, want %v", key, got, want)
+			}
+		case 1:
+			if got, want := l.Add(key, i), d.Add(key, i); got != want {
+				t.Fatalf("Add(%v) error = %v, want %v", key, got, want)
+			}
+		default:
+			l.Set(key, i)
+			d.Set(key, i)
+		}
+	}
+
+	if got, want := l.String(), d.String(); got != want {
+		t.Fatalf("String() = %v, want %v", got, want)
+	}
+	for k, v := range d.All() {
+		i := l.IndexOfKey(k)
+		if key, err := l.GetKeyAt(i); key != k || err != nil {
+			t.Errorf("GetKeyAt(%v) = %v, %v, want %v, %v", i, key, err, k, nil)
+		}
+		if value, err := l.GetValueAt(i); value != v || err != nil {
+			t.Errorf("GetValueAt(%v) = %v, %v, want %v, %v", i, value, err, v, nil)
+		}
+	}
+}
+
+func TestSortedList_Get(t *testing.T) {
+	l := NewSortedList[string, int](strings.Compare)
+	_ = l.Add("b", 2)
+	_ = l.Add("a", 1)
+	_ = l.Add("c", 3)
+
+	if err := l.Add("a", 4); err != ErrDuplicateKey {
+		t.Errorf("Add() error = %v, want %v", err, ErrDuplicateKey)
+	}
+	if got, err := l.Get("b"); got != 2 || err != nil {
+		t.Errorf("Get() = %v, %v, want %v, %v", got, err, 2, nil)
+	}
+	if _, err := l.Get("d"); err != ErrKeyNotFound {
+		t.Errorf("Get() error = %v, want %v", err, ErrKeyNotFound)
+	}
+	if _, ok := l.TryGet("d"); ok || !l.ContainsKey("c") {
+		t.Errorf("TryGet() or ContainsKey() does not match the list")
+	}
+	if got := l.IndexOfKey("c"); got != 2 {
+		t.Errorf("IndexOfKey(c) = %v, want %v", got, 2)
+	}
+	if got := l.IndexOfKey("bb"); got != -1 {
+		t.Errorf("IndexOfKey(bb) = %v, want %v", got, -1)
+	}
+	if got := slices.Collect(l.Keys()); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("Keys() = %v", got)
+	}
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Values() = %v", got)
+	}
+}
+
+func TestSortedList_At(t *testing.T) {
+	l := NewSortedList[int, string](compareInts)
+	for _, k := range []int{30, 10, 20} {
+		l.Set(k, fmt.Sprint(k))
+	}
+
+	for _, i := range []int{-1, 3} {
+		if _, err := l.GetKeyAt(i); err != ErrIndexOutOfRange {
+			t.Errorf("GetKeyAt(%v) error = %v, want %v", i, err, ErrIndexOutOfRange)
+		}
+		if _, err := l.GetValueAt(i); err != ErrIndexOutOfRange {
+			t.Errorf("GetValueAt(%v) error = %v, want %v", i, err, ErrIndexOutOfRange)
+		}
+		if err := l.SetValueAt(i, ""); err != ErrIndexOutOfRange {
+			t.Errorf("SetValueAt(%v) error = %v, want %v", i, err, ErrIndexOutOfRange)
+		}
+		if err := l.RemoveAt(i); err != ErrIndexOutOfRange {
+			t.Errorf("RemoveAt(%v) error = %v, want %v", i, err, ErrIndexOutOfRange)
+		}
+	}
+
+	if err := l.SetValueAt(1, "twenty"); err != nil {
+		t.Fatalf("SetValueAt() error = %v", err)
+	}
+	if err := l.RemoveAt(0); err != nil {
+		t.Fatalf("RemoveAt() error = %v", err)
+	}
+	if got := l.String(); got != "map[20:twenty 30:30]" {
+		t.Errorf("String() = %v, want %v", got, "map[20:twenty 30:30]")
+	}
+
+	l.Clear()
+	if !l.IsEmpty() || l.Size() != 0 {
+		t.Errorf("Clear() left %v keys", l.Size())
+	}
+}
+
+func TestSortedList_Range(t *testing.T) {
+	l := NewSortedList[int, int](compareInts)
+	for k := 10; k <= 50; k += 10 {
+		l.Set(k, k*2)
+	}
+
+	tests := []struct {
+		lo, hi int
+		want   []int
+	}{
+		{lo: 20, hi: 40, want: []int{20, 30, 40}},
+		{lo: 15, hi: 45, want: []int{20, 30, 40}},
+		{lo: 0, hi: 100, want: []int{10, 20, 30, 40, 50}},
+		{lo: 41, hi: 49, want: nil},
+		{lo: 40, hi: 20, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.lo, "-", tt.hi), func(t *testing.T) {
+			var got []int
+			for k, v := range l.Range(tt.lo, tt.hi) {
+				if v != k*2 {
+					t.Errorf("value of %v = %v, want %v", k, v, k*2)
+				}
+				got = append(got, k)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Range() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleSortedList() {
+	l := NewSortedList[string, int](strings.Compare)
+	l.Set("cherry", 3)
+	l.Set("apple", 1)
+	l.Set("banana", 2)
+
+	for k, v := range l.All() {
+		fmt.Println(k, v)
+	}
+	key, _ := l.GetKeyAt(1)
+	fmt.Println(key, l.IndexOfKey("cherry"))
+	// Output:
+	// apple 1
+	// banana 2
+	// cherry 3
+	// banana 2
+}
@@ -0,0 +1,269 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SortedSet implements a set of items kept in ascending order, as determined
+// by a comparer. Items that compare as equal are the same item, so each
+// appears at most once. It is modelled on .NET's SortedSet<T>, and also
+// answers ordered queries such as Floor, Ceiling and Select. The items are
+// stored in a balanced binary tree, so lookups, insertions, removals and
+// ordered queries take O(log n) time. It is not thread-safe.
+//
+// GetViewBetween returns a SortedSet that is a live view of part of another
+// set. The view and the set share their items, so changes made through either
+// are visible through both.
+type SortedSet[T any] struct {
+	tree    *sortedTree[T, struct{}]
+	lo      T
+	hi      T
+	bounded bool
+}
+
+// NewSortedSet returns a new set that orders items using the given comparer,
+// with the given initial items. Duplicate items are added once.
+func NewSortedSet[T any](comparer Comparer[T], values ...T) *SortedSet[T] {
+	s := &SortedSet[T]{tree: &sortedTree[T, struct{}]{comparer: comparer}}
+	for _, v := range values {
+		s.tree.put(v, struct{}{}, false)
+	}
+	return s
+}
+
+// Add adds an item to the set and returns true if it was not already in the
+// set. If the set is a view and the item is outside its range, the item is not
+// added and false is returned.
+func (s *SortedSet[T]) Add(item T) bool {
+	if !s.inRange(item) {
+		return false
+	}
+	return s.tree.put(item, struct{}{}, false)
+}
+
+// Remove removes the given item from the set. If the item is not found, false
+// is returned, otherwise true is returned.
+func (s *SortedSet[T]) Remove(item T) bool {
+	return s.inRange(item) && s.tree.remove(item)
+}
+
+// Contains returns true if the set contains the given item.
+func (s *SortedSet[T]) Contains(item T) bool {
+	return s.inRange(item) && s.tree.get(item) != nil
+}
+
+// Size returns the number of items in the set.
+func (s *SortedSet[T]) Size() int {
+	if !s.bounded {
+		return s.tree.len()
+	}
+	return max(0, s.tree.rank(s.hi, true)-s.tree.rank(s.lo, false))
+}
+
+// IsEmpty returns true if the set is empty.
+func (s *SortedSet[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all items from the set. If the set is a view, only the items
+// in its range are removed from the underlying set.
+func (s *SortedSet[T]) Clear() {
+	if !s.bounded {
+		s.tree.clear()
+		return
+	}
+
+	items := make([]T, 0, s.Size())
+	for v := range s.Values() {
+		items = append(items, v)
+	}
+	for _, v := range items {
+		s.tree.remove(v)
+	}
+}
+
+// CopyTo copies the items in the set, in ascending order, to the given slice,
+// starting at the given index. If the index is out of range, an error is
+// returned. If the slice is not large enough to hold all the items, an error
+// is returned.
+func (s *SortedSet[T]) CopyTo(items []T, index int) error {
+	if index < 0 || index > len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(items)-index < s.Size() {
+		return ErrIndexOutOfRange
+	}
+
+	for v := range s.Values() {
+		items[index] = v
+		index++
+	}
+
+	return nil
+}
+
+// Min returns the smallest item in the set. If the set is empty, false is
+// returned.
+func (s *SortedSet[T]) Min() (T, bool) {
+	if !s.bounded {
+		return s.item(s.tree.first())
+	}
+	return s.item(s.tree.ceiling(s.lo, false))
+}
+
+// Max returns the largest item in the set. If the set is empty, false is
+// returned.
+func (s *SortedSet[T]) Max() (T, bool) {
+	if !s.bounded {
+		return s.item(s.tree.last())
+	}
+	return s.item(s.tree.floor(s.hi, false))
+}
+
+// Floor returns the largest item that is less than or equal to the given item.
+// If there is no such item, false is returned.
+func (s *SortedSet[T]) Floor(item T) (T, bool) {
+	if s.bounded && s.tree.comparer(item, s.hi) > 0 {
+		return s.Max()
+	}
+	return s.item(s.tree.floor(item, false))
+}
+
+// Ceiling returns the smallest item that is greater than or equal to the given
+// item. If there is no such item, false is returned.
+func (s *SortedSet[T]) Ceiling(item T) (T, bool) {
+	if s.bounded && s.tree.comparer(item, s.lo) < 0 {
+		return s.Min()
+	}
+	return s.item(s.tree.ceiling(item, false))
+}
+
+// Predecessor returns the largest item that is less than the given item. If
+// there is no such item, false is returned.
+func (s *SortedSet[T]) Predecessor(item T) (T, bool) {
+	if s.bounded && s.tree.comparer(item, s.hi) > 0 {
+		return s.Max()
+	}
+	return s.item(s.tree.floor(item, true))
+}
+
+// Successor returns the smallest item that is greater than the given item. If
+// there is no such item, false is returned.
+func (s *SortedSet[T]) Successor(item T) (T, bool) {
+	if s.bounded && s.tree.comparer(item, s.lo) < 0 {
+		return s.Min()
+	}
+	return s.item(s.tree.ceiling(item, true))
+}
+
+// RankOf returns the number of items in the set that are less than the given
+// item. If the item is in the set, this is its index in ascending order.
+func (s *SortedSet[T]) RankOf(item T) int {
+	if !s.bounded {
+		return s.tree.rank(item, false)
+	}
+	return min(max(0, s.tree.rank(item, false)-s.tree.rank(s.lo, false)), s.Size())
+}
+
+// Select returns the item with the given rank, counting from zero in ascending
+// order. If the rank is out of range, an error is returned.
+func (s *SortedSet[T]) Select(rank int) (T, error) {
+	if rank < 0 || rank >= s.Size() {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+	if s.bounded {
+		rank += s.tree.rank(s.lo, false)
+	}
+	return s.tree.at(rank).key, nil
+}
+
+// Range returns an iterator over the items between lo and hi, inclusive, in
+// ascending order. The set should not be modified during iteration.
+func (s *SortedSet[T]) Range(lo T, hi T) iter.Seq[T] {
+	lo, hi = s.clamp(lo, hi)
+	return func(yield func(T) bool) {
+		s.tree.ascend(s.tree.root, &lo, &hi, func(n *sortedTreeNode[T, struct{}]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// GetViewBetween returns a live view of the items between lo and hi,
+// inclusive. Changes made to the set are visible through the view, and items
+// added to or removed from the view are added to or removed from the set. If
+// the set is itself a view, the new view covers only the part of the range
+// that lies within the set's range.
+func (s *SortedSet[T]) GetViewBetween(lo T, hi T) *SortedSet[T] {
+	lo, hi = s.clamp(lo, hi)
+	return &SortedSet[T]{tree: s.tree, lo: lo, hi: hi, bounded: true}
+}
+
+// Values returns an iterator over the items in the set, in ascending order.
+// The set should not be modified during iteration.
+func (s *SortedSet[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		lo, hi := s.bounds()
+		s.tree.ascend(s.tree.root, lo, hi, func(n *sortedTreeNode[T, struct{}]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// Backward returns an iterator over the items in the set, in descending
+// order. The set should not be modified during iteration.
+func (s *SortedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		lo, hi := s.bounds()
+		s.tree.descend(s.tree.root, lo, hi, func(n *sortedTreeNode[T, struct{}]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// String returns a string representation of the set, in ascending order.
+func (s *SortedSet[T]) String() string {
+	items := make([]T, s.Size())
+	_ = s.CopyTo(items, 0)
+	return fmt.Sprintf("%v", items)
+}
+
+// inRange returns true if the item lies within the range of the view, or if
+// the set is not a view.
+func (s *SortedSet[T]) inRange(item T) bool {
+	return !s.bounded || (s.tree.comparer(item, s.lo) >= 0 && s.tree.comparer(item, s.hi) <= 0)
+}
+
+// bounds returns the range of the view, or nil bounds if the set is not a
+// view.
+func (s *SortedSet[T]) bounds() (*T, *T) {
+	if !s.bounded {
+		return nil, nil
+	}
+	return &s.lo, &s.hi
+}
+
+// clamp narrows the given range to the range of the view.
+func (s *SortedSet[T]) clamp(lo T, hi T) (T, T) {
+	if s.bounded {
+		if s.tree.comparer(lo, s.lo) < 0 {
+			lo = s.lo
+		}
+		if s.tree.comparer(hi, s.hi) > 0 {
+			hi = s.hi
+		}
+	}
+	return lo, hi
+}
+
+// item returns the key of the given node and true if the node is not nil and
+// lies within the range of the view.
+func (s *SortedSet[T]) item(n *sortedTreeNode[T, struct{}]) (T, bool) {
+	if n == nil || !s.inRange(n.key) {
+		var zero T
+		return zero, false
+	}
+	return n.key, true
+}
@@ -0,0 +1,215 @@
+package collections
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestSortedSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := NewSortedSet[int](compareInts)
+	want := map[int]bool{}
+
+	for i := 0; i < 3000; i++ {
+		item := r.Intn(300)
+		if r.Intn(3) == 0 {
+			if got := s.Remove(item); got != want[item] {
+				t.Fatalf("Remove(%v) = %v, want %v", item, got, want[item])
+			}
+			delete(want, item)
+		} else {
+			if got := s.Add(item); got == want[item] {
+				t.Fatalf("Add(%v) = %v, want %v", item, got, !want[item])
+			}
+			want[item] = true
+		}
+	}
+	checkSortedTree(t, s.tree)
+
+	items := make([]int, 0, len(want))
+	for k := range want {
+		items = append(items, k)
+	}
+	slices.Sort(items)
+
+	if got := slices.Collect(s.Values()); !slices.Equal(got, items) {
+		t.Fatalf("Values() = %v, want %v", got, items)
+	}
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, reversed(items)) {
+		t.Fatalf("Backward() = %v", got)
+	}
+	for i, item := range items {
+		if got := s.RankOf(item); got != i {
+			t.Errorf("RankOf(%v) = %v, want %v", item, got, i)
+		}
+		if got, err := s.Select(i); got != item || err != nil {
+			t.Errorf("Select(%v) = %v, %v, want %v, %v", i, got, err, item, nil)
+		}
+	}
+}
+
+// reversed returns a reversed copy of the given items.
+func reversed[T any](items []T) []T {
+	r := slices.Clone(items)
+	slices.Reverse(r)
+	return r
+}
+
+func TestSortedSet_Queries(t *testing.T) {
+	s := NewSortedSet[int](compareInts, 30, 10, 20, 10)
+	if got := s.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+	if got := s.String(); got != "[10 20 30]" {
+		t.Errorf("String() = %v, want %v", got, "[10 20 30]")
+	}
+	if !s.Contains(20) || s.Contains(25) {
+		t.Errorf("Contains() does not match the set")
+	}
+
+	check := func(name string, got int, ok bool, want int, wantOk bool) {
+		t.Helper()
+		if ok != wantOk || (ok && got != want) {
+			t.Errorf("%v = %v, %v, want %v, %v", name, got, ok, want, wantOk)
+		}
+	}
+	got, ok := s.Min()
+	check("Min()", got, ok, 10, true)
+	got, ok = s.Max()
+	check("Max()", got, ok, 30, true)
+	got, ok = s.Floor(25)
+	check("Floor(25)", got, ok, 20, true)
+	got, ok = s.Ceiling(25)
+	check("Ceiling(25)", got, ok, 30, true)
+	got, ok = s.Predecessor(20)
+	check("Predecessor(20)", got, ok, 10, true)
+	got, ok = s.Successor(30)
+	check("Successor(30)", got, ok, 0, false)
+
+	if got := slices.Collect(s.Range(15, 100)); !slices.Equal(got, []int{20, 30}) {
+		t.Errorf("Range() = %v", got)
+	}
+
+	items := make([]int, 3)
+	if err := s.CopyTo(items, 0); err != nil || !slices.Equal(items, []int{10, 20, 30}) {
+		t.Errorf("CopyTo() = %v, %v", items, err)
+	}
+	if err := s.CopyTo(items, 1); err != ErrIndexOutOfRange {
+		t.Errorf("CopyTo() error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Errorf("Clear() left %v items", s.Size())
+	}
+}
+
+func TestSortedSet_GetViewBetween(t *testing.T) {
+	s := NewSortedSet[int](compareInts, 10, 20, 30, 40, 50)
+	v := s.GetViewBetween(20, 40)
+
+	if got := v.String(); got != "[20 30 40]" {
+		t.Errorf("String() = %v, want %v", got, "[20 30 40]")
+	}
+
+	// Changes to the set are visible through the view.
+	s.Add(25)
+	s.Add(45)
+	s.Remove(40)
+	if got := slices.Collect(v.Values()); !slices.Equal(got, []int{20, 25, 30}) {
+		t.Errorf("Values() = %v, want %v", got, []int{20, 25, 30})
+	}
+	if got := v.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+
+	// Changes through the view are visible in the set, and items outside
+	// the range are rejected.
+	if !v.Add(35) || v.Add(60) || v.Remove(10) {
+		t.Errorf("Add() or Remove() ignored the range of the view")
+	}
+	if !s.Contains(35) || s.Contains(60) || !s.Contains(10) {
+		t.Errorf("set = %v", s)
+	}
+	if v.Contains(10) {
+		t.Errorf("Contains(10) = true, want false")
+	}
+
+	check := func(name string, got int, ok bool, want int, wantOk bool) {
+		t.Helper()
+		if ok != wantOk || (ok && got != want) {
+			t.Errorf("%v = %v, %v, want %v, %v", name, got, ok, want, wantOk)
+		}
+	}
+	got, ok := v.Min()
+	check("Min()", got, ok, 20, true)
+	got, ok = v.Max()
+	check("Max()", got, ok, 35, true)
+	got, ok = v.Floor(100)
+	check("Floor(100)", got, ok, 35, true)
+	got, ok = v.Floor(15)
+	check("Floor(15)", got, ok, 0, false)
+	got, ok = v.Ceiling(0)
+	check("Ceiling(0)", got, ok, 20, true)
+	got, ok = v.Successor(35)
+	check("Successor(35)", got, ok, 0, false)
+	got, ok = v.Predecessor(20)
+	check("Predecessor(20)", got, ok, 0, false)
+
+	if got := v.RankOf(30); got != 2 {
+		t.Errorf("RankOf(30) = %v, want %v", got, 2)
+	}
+	if got := v.RankOf(100); got != 4 {
+		t.Errorf("RankOf(100) = %v, want %v", got, 4)
+	}
+	if got, err := v.Select(0); got != 20 || err != nil {
+		t.Errorf("Select(0) = %v, %v, want %v, %v", got, err, 20, nil)
+	}
+	if _, err := v.Select(4); err != ErrIndexOutOfRange {
+		t.Errorf("Select(4) error = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	// A view of a view is limited to the range of both.
+	inner := v.GetViewBetween(0, 25)
+	if got := inner.String(); got != "[20 25]" {
+		t.Errorf("GetViewBetween() = %v, want %v", got, "[20 25]")
+	}
+
+	// Clearing a view only removes the items in its range.
+	v.Clear()
+	if got := s.String(); got != "[10 45 50]" {
+		t.Errorf("Clear() left %v, want %v", got, "[10 45 50]")
+	}
+	if !v.IsEmpty() || !inner.IsEmpty() {
+		t.Errorf("Clear() left items in the view")
+	}
+
+	empty := s.GetViewBetween(50, 10)
+	if !empty.IsEmpty() || empty.Add(30) {
+		t.Errorf("a view with an empty range is not empty")
+	}
+}
+
+func BenchmarkSortedSet_Add(b *testing.B) {
+	s := NewSortedSet[int](compareInts)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Add(i)
+	}
+}
+
+func ExampleSortedSet_GetViewBetween() {
+	s := NewSortedSet[int](compareInts, 1, 5, 10, 15, 20)
+	view := s.GetViewBetween(5, 15)
+
+	s.Add(12)
+	fmt.Println(view)
+
+	view.Remove(10)
+	fmt.Println(s)
+	// Output:
+	// [5 10 12 15]
+	// [1 5 12 15 20]
+}
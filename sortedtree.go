@@ -0,0 +1,324 @@
+package collections
+
+// sortedTreeNode is a node in a sortedTree. Each node records the height and
+// the number of nodes of its subtree, so that the tree can be kept balanced
+// and nodes can be found by rank.
+type sortedTreeNode[K any, V any] struct {
+	key    K
+	value  V
+	left   *sortedTreeNode[K, V]
+	right  *sortedTreeNode[K, V]
+	height int
+	size   int
+}
+
+// sortedTree implements an AVL tree of keys and values ordered by a comparer.
+// Lookups, insertions, removals and rank queries take O(log n) time. It backs
+// SortedDictionary and SortedSet.
+type sortedTree[K any, V any] struct {
+	root     *sortedTreeNode[K, V]
+	comparer Comparer[K]
+}
+
+// len returns the number of nodes in the tree.
+func (t *sortedTree[K, V]) len() int {
+	return t.root.count()
+}
+
+// get returns the node with the given key, or nil.
+func (t *sortedTree[K, V]) get(key K) *sortedTreeNode[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.comparer(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// put adds the key and value and returns true if the key is new. If the key is
+// not new, the value is replaced only if overwrite is true.
+func (t *sortedTree[K, V]) put(key K, value V, overwrite bool) bool {
+	var added bool
+	t.root = t.insert(t.root, key, value, overwrite, &added)
+	return added
+}
+
+// insert adds the key and value to the subtree rooted at n and returns the new
+// root of the subtree.
+func (t *sortedTree[K, V]) insert(n *sortedTreeNode[K, V], key K, value V, overwrite bool, added *bool) *sortedTreeNode[K, V] {
+	if n == nil {
+		*added = true
+		return &sortedTreeNode[K, V]{key: key, value: value, height: 1, size: 1}
+	}
+
+	c := t.comparer(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.insert(n.left, key, value, overwrite, added)
+	case c > 0:
+		n.right = t.insert(n.right, key, value, overwrite, added)
+	default:
+		if overwrite {
+			n.value = value
+		}
+		return n
+	}
+	return n.balance()
+}
+
+// remove removes the node with the given key and returns true if it was found.
+func (t *sortedTree[K, V]) remove(key K) bool {
+	var removed bool
+	t.root = t.delete(t.root, key, &removed)
+	return removed
+}
+
+// delete removes the key from the subtree rooted at n and returns the new root
+// of the subtree.
+func (t *sortedTree[K, V]) delete(n *sortedTreeNode[K, V], key K, removed *bool) *sortedTreeNode[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	c := t.comparer(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.delete(n.left, key, removed)
+	case c > 0:
+		n.right = t.delete(n.right, key, removed)
+	default:
+		*removed = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+
+		// Replace the node with its successor, the smallest node on the right.
+		var successor *sortedTreeNode[K, V]
+		n.right, successor = n.right.removeFirst()
+		successor.left, successor.right = n.left, n.right
+		n = successor
+	}
+	return n.balance()
+}
+
+// clear removes all nodes from the tree.
+func (t *sortedTree[K, V]) clear() {
+	t.root = nil
+}
+
+// first returns the node with the smallest key, or nil if the tree is empty.
+func (t *sortedTree[K, V]) first() *sortedTreeNode[K, V] {
+	n := t.root
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// last returns the node with the largest key, or nil if the tree is empty.
+func (t *sortedTree[K, V]) last() *sortedTreeNode[K, V] {
+	n := t.root
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// floor returns the node with the largest key that is less than or equal to
+// the given key, or strictly less if strict is true. It returns nil if there is
+// no such node.
+func (t *sortedTree[K, V]) floor(key K, strict bool) *sortedTreeNode[K, V] {
+	var found *sortedTreeNode[K, V]
+	for n := t.root; n != nil; {
+		c := t.comparer(n.key, key)
+		if c < 0 || (c == 0 && !strict) {
+			found = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return found
+}
+
+// ceiling returns the node with the smallest key that is greater than or equal
+// to the given key, or strictly greater if strict is true. It returns nil if
+// there is no such node.
+func (t *sortedTree[K, V]) ceiling(key K, strict bool) *sortedTreeNode[K, V] {
+	var found *sortedTreeNode[K, V]
+	for n := t.root; n != nil; {
+		c := t.comparer(n.key, key)
+		if c > 0 || (c == 0 && !strict) {
+			found = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return found
+}
+
+// rank returns the number of keys that are less than the given key, or less
+// than or equal to it if inclusive is true.
+func (t *sortedTree[K, V]) rank(key K, inclusive bool) int {
+	r := 0
+	for n := t.root; n != nil; {
+		c := t.comparer(n.key, key)
+		if c < 0 || (c == 0 && inclusive) {
+			r += n.left.count() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return r
+}
+
+// at returns the node with the given rank, counting from zero. The rank must
+// be in range.
+func (t *sortedTree[K, V]) at(rank int) *sortedTreeNode[K, V] {
+	n := t.root
+	for {
+		left := n.left.count()
+		switch {
+		case rank < left:
+			n = n.left
+		case rank > left:
+			rank -= left + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+// ascend calls yield for each node, in ascending order, whose key is at least
+// lo and at most hi, until yield returns false. A nil bound is unbounded. It
+// returns false if iteration was stopped.
+func (t *sortedTree[K, V]) ascend(n *sortedTreeNode[K, V], lo, hi *K, yield func(*sortedTreeNode[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo == nil || t.comparer(n.key, *lo) >= 0
+	belowHi := hi == nil || t.comparer(n.key, *hi) <= 0
+	if aboveLo && !t.ascend(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n) {
+		return false
+	}
+	if belowHi {
+		return t.ascend(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+// descend calls yield for each node, in descending order, whose key is at
+// least lo and at most hi, until yield returns false. A nil bound is
+// unbounded. It returns false if iteration was stopped.
+func (t *sortedTree[K, V]) descend(n *sortedTreeNode[K, V], lo, hi *K, yield func(*sortedTreeNode[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo == nil || t.comparer(n.key, *lo) >= 0
+	belowHi := hi == nil || t.comparer(n.key, *hi) <= 0
+	if belowHi && !t.descend(n.right, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n) {
+		return false
+	}
+	if aboveLo {
+		return t.descend(n.left, lo, hi, yield)
+	}
+	return true
+}
+
+// count returns the number of nodes in the subtree rooted at n.
+func (n *sortedTreeNode[K, V]) count() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// depth returns the height of the subtree rooted at n.
+func (n *sortedTreeNode[K, V]) depth() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and size of n from its children.
+func (n *sortedTreeNode[K, V]) update() {
+	n.height = 1 + max(n.left.depth(), n.right.depth())
+	n.size = 1 + n.left.count() + n.right.count()
+}
+
+// removeFirst removes the node with the smallest key from the subtree rooted
+// at n. It returns the new root of the subtree and the removed node.
+func (n *sortedTreeNode[K, V]) removeFirst() (*sortedTreeNode[K, V], *sortedTreeNode[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var first *sortedTreeNode[K, V]
+	n.left, first = n.left.removeFirst()
+	return n.balance(), first
+}
+
+// balance updates n and rotates it if its subtrees differ in height by more
+// than one. It returns the new root of the subtree.
+func (n *sortedTreeNode[K, V]) balance() *sortedTreeNode[K, V] {
+	n.update()
+	switch diff := n.left.depth() - n.right.depth(); {
+	case diff > 1:
+		if n.left.left.depth() < n.left.right.depth() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case diff < -1:
+		if n.right.right.depth() < n.right.left.depth() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	default:
+		return n
+	}
+}
+
+// rotateLeft makes the right child of n the root of the subtree.
+func (n *sortedTreeNode[K, V]) rotateLeft() *sortedTreeNode[K, V] {
+	r := n.right
+	n.right = r.left
+	n.update()
+	r.left = n
+	r.update()
+	return r
+}
+
+// rotateRight makes the left child of n the root of the subtree.
+func (n *sortedTreeNode[K, V]) rotateRight() *sortedTreeNode[K, V] {
+	l := n.left
+	n.left = l.right
+	n.update()
+	l.right = n
+	l.update()
+	return l
+}
@@ -2,19 +2,25 @@ package collections
 
 import (
 	"fmt"
+	"iter"
 	"sync"
 )
 
-
-
 // Stack implements a LIFO data structure. It is not thread-safe.
 type Stack[T any] struct {
-	items []T
+	items    []T
+	comparer EqualityComparer[T]
 }
 
 // NewStack returns a new stack with the given initial items.
-func NewStack[T any](values ...T) *Stack[T] {
-	return &Stack[T]{items: values}
+func NewStack[T comparable](values ...T) *Stack[T] {
+	return &Stack[T]{items: values, comparer: DefaultEqualityComparer[T]}
+}
+
+// NewStackWithEqualityComparer returns a new stack with the given initial
+// items that uses the given comparer to find items.
+func NewStackWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *Stack[T] {
+	return &Stack[T]{items: values, comparer: comparer}
 }
 
 // Push adds an item to the top of the stack.
@@ -55,44 +61,118 @@ func (s *Stack[T]) Size() int {
 	return len(s.items)
 }
 
+// Values returns an iterator over the items in the stack, from top to bottom,
+// without removing them. The stack should not be modified during iteration.
+func (s *Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if i >= len(s.items) {
+				continue
+			}
+			if !yield(s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops items from the top of the stack as they
+// are consumed. Iteration ends when the stack is empty. If the loop is exited
+// early, the items that were not consumed remain on the stack.
+func (s *Stack[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			item, err := s.Pop()
+			if err != nil {
+				return
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
 // String returns a string representation of the stack.
 func (s *Stack[T]) String() string {
 	return fmt.Sprintf("%v", s.items)
 }
 
+// Contains returns true if the stack contains the given item.
+func (s *Stack[T]) Contains(item T) bool {
+	return containsItem(s.items, item, s.comparer)
+}
+
+// Clear removes all items from the stack.
+func (s *Stack[T]) Clear() {
+	s.items = []T{}
+}
+
+// CopyTo copies the items in the stack, from top to bottom, to the given
+// slice, starting at the given index. If the index is out of range, an error
+// is returned. If the slice is not large enough to hold all the items, an
+// error is returned.
+func (s *Stack[T]) CopyTo(items []T, index int) error {
+	return copyStack(s.items, items, index)
+}
+
 // ConcurrentStack implements a LIFO data structure. It is thread-safe.
+//
+// The stack can be closed to signal that no more items will be pushed. The
+// items that remain can still be popped; once the stack is closed and empty,
+// popping returns ErrClosed and the channel returned by Done is closed.
 type ConcurrentStack[T any] struct {
-	items []T
-	lock  sync.RWMutex
+	items    []T
+	closed   bool
+	done     chan struct{}
+	comparer EqualityComparer[T]
+	lock     sync.RWMutex
 }
 
 // NewConcurrentStack returns a new stack with the given initial items.
-func NewConcurrentStack[T any](values ...T) *ConcurrentStack[T] {
-	return &ConcurrentStack[T]{items: values}
+func NewConcurrentStack[T comparable](values ...T) *ConcurrentStack[T] {
+	return &ConcurrentStack[T]{items: values, comparer: DefaultEqualityComparer[T]}
 }
 
-// Push adds an item to the top of the stack.
-func (s *ConcurrentStack[T]) Push(item T) {
+// NewConcurrentStackWithEqualityComparer returns a new stack with the given
+// initial items that uses the given comparer to find items.
+func NewConcurrentStackWithEqualityComparer[T any](comparer EqualityComparer[T], values ...T) *ConcurrentStack[T] {
+	return &ConcurrentStack[T]{items: values, comparer: comparer}
+}
+
+// Push adds an item to the top of the stack. If the stack is closed, ErrClosed
+// is returned.
+func (s *ConcurrentStack[T]) Push(item T) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
+	if s.closed {
+		return ErrClosed
+	}
 	s.items = append(s.items, item)
+	return nil
 }
 
 // Pop removes and returns the item at the top of the stack. If the stack is
+// empty, ErrEmptyStack is returned, or ErrClosed if the stack is also closed.
 func (s *ConcurrentStack[T]) Pop() (T, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	var zero T
 	if len(s.items) == 0 {
+		if s.closed {
+			return zero, ErrClosed
+		}
 		return zero, ErrEmptyStack
 	}
 
 	item := s.items[len(s.items)-1]
 	s.items = s.items[:len(s.items)-1]
+	s.checkDone()
 	return item, nil
 }
 
 // Peek returns the item at the top of the stack without removing it. If the
+// stack is empty, an error is returned.
 func (s *ConcurrentStack[T]) Peek() (T, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -117,9 +197,145 @@ func (s *ConcurrentStack[T]) Size() int {
 	return len(s.items)
 }
 
+// Values returns an iterator over the items in the stack, from top to bottom,
+// without removing them. The iterator works on a snapshot of the stack taken
+// when iteration starts, so the lock is not held while the loop body runs and
+// changes made to the stack during iteration are not visible to the iterator.
+func (s *ConcurrentStack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.lock.RLock()
+		items := make([]T, len(s.items))
+		copy(items, s.items)
+		s.lock.RUnlock()
+
+		for i := len(items) - 1; i >= 0; i-- {
+			if !yield(items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops items from the top of the stack as they
+// are consumed. Each item is popped under the lock, but the lock is not held
+// while the loop body runs, so items pushed by other goroutines during
+// iteration are drained as well and other consumers may receive some of the
+// items. Iteration ends when the stack is empty. If the loop is exited early,
+// the items that were not consumed remain on the stack.
+func (s *ConcurrentStack[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			item, err := s.Pop()
+			if err != nil {
+				return
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
 // String returns a string representation of the stack.
 func (s *ConcurrentStack[T]) String() string {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 	return fmt.Sprintf("%v", s.items)
 }
+
+// Contains returns true if the stack contains the given item.
+func (s *ConcurrentStack[T]) Contains(item T) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return containsItem(s.items, item, s.comparer)
+}
+
+// Clear removes all items from the stack.
+func (s *ConcurrentStack[T]) Clear() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.items = []T{}
+	s.checkDone()
+}
+
+// CopyTo copies the items in the stack, from top to bottom, to the given
+// slice, starting at the given index. If the index is out of range, an error
+// is returned. If the slice is not large enough to hold all the items, an
+// error is returned.
+func (s *ConcurrentStack[T]) CopyTo(items []T, index int) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return copyStack(s.items, items, index)
+}
+
+// Close marks the stack as closed. Pushing an item onto a closed stack returns
+// ErrClosed. The items already on the stack can still be popped; once the
+// stack is empty, popping returns ErrClosed. Closing a closed stack has no
+// effect.
+func (s *ConcurrentStack[T]) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	s.checkDone()
+}
+
+// IsClosed returns true if the stack has been closed.
+func (s *ConcurrentStack[T]) IsClosed() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.closed
+}
+
+// Done returns a channel that is closed once the stack has been closed and
+// every remaining item has been popped.
+func (s *ConcurrentStack[T]) Done() <-chan struct{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.done == nil {
+		s.done = make(chan struct{})
+		s.checkDone()
+	}
+	return s.done
+}
+
+// checkDone closes the done channel if the stack is closed and empty. The
+// caller must hold the lock.
+func (s *ConcurrentStack[T]) checkDone() {
+	if !s.closed || len(s.items) > 0 || s.done == nil {
+		return
+	}
+	select {
+	case <-s.done:
+	default:
+		close(s.done)
+	}
+}
+
+// containsItem returns true if the given items contain the given item,
+// according to the given comparer.
+func containsItem[T any](items []T, item T, comparer EqualityComparer[T]) bool {
+	for _, v := range items {
+		if comparer(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyStack copies the items of a stack, from top to bottom, to dst, starting
+// at the given index.
+func copyStack[T any](items []T, dst []T, index int) error {
+	if index < 0 || index > len(dst) {
+		return ErrIndexOutOfRange
+	}
+
+	if len(dst)-index < len(items) {
+		return ErrIndexOutOfRange
+	}
+
+	for i := range items {
+		dst[index+i] = items[len(items)-1-i]
+	}
+
+	return nil
+}
@@ -94,7 +94,7 @@ func TestNewStack(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewStack(tt.args.values...); !reflect.DeepEqual(got, tt.want) {
+			if got := NewStack(tt.args.values...); !reflect.DeepEqual(got.items, tt.want.items) {
 				t.Errorf("NewStack() = %v, want %v", got, tt.want)
 			}
 		})
@@ -566,9 +566,200 @@ func TestNewConcurrentStack(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewConcurrentStack(tt.args.values...); !reflect.DeepEqual(got, tt.want) {
+			if got := NewConcurrentStack(tt.args.values...); !reflect.DeepEqual(got.items, tt.want.items) {
 				t.Errorf("NewConcurrentStack() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestStack_Values(t *testing.T) {
+	stack := NewStack[int](1, 2, 3)
+
+	var got []int
+	for v := range stack.Values() {
+		got = append(got, v)
+	}
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if stack.Size() != 3 {
+		t.Errorf("Values() removed items from the stack")
+	}
+}
+
+func TestStack_Drain(t *testing.T) {
+	type testCase[T any] struct {
+		name     string
+		s        *Stack[T]
+		limit    int
+		want     []T
+		wantSize int
+	}
+	tests := []testCase[int]{
+		{
+			name:     "All",
+			s:        NewStack[int](1, 2, 3),
+			limit:    10,
+			want:     []int{3, 2, 1},
+			wantSize: 0,
+		},
+		{
+			name:     "Break",
+			s:        NewStack[int](1, 2, 3),
+			limit:    1,
+			want:     []int{3},
+			wantSize: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for v := range tt.s.Drain() {
+				got = append(got, v)
+				if len(got) == tt.limit {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Drain() = %v, want %v", got, tt.want)
+			}
+			if size := tt.s.Size(); size != tt.wantSize {
+				t.Errorf("Drain() left %v items, want %v", size, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestConcurrentStack_Values(t *testing.T) {
+	stack := NewConcurrentStack[int](1, 2, 3)
+
+	var got []int
+	for v := range stack.Values() {
+		// The iterator works on a snapshot, so mutating the stack must neither
+		// deadlock nor change what is being iterated.
+		_, _ = stack.Pop()
+		got = append(got, v)
+	}
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestConcurrentStack_Drain(t *testing.T) {
+	stack := NewConcurrentStack[int]()
+	for i := 0; i < 1000; i++ {
+		stack.Push(i)
+	}
+
+	var wg sync.WaitGroup
+	counts := make([]int, 4)
+	for w := range counts {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for range stack.Drain() {
+				counts[w]++
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	if total != 1000 {
+		t.Errorf("Drain() consumed %v items, want %v", total, 1000)
+	}
+	if !stack.IsEmpty() {
+		t.Errorf("Drain() left %v items", stack.Size())
+	}
+}
+
+func ExampleStack_Values() {
+	stack := NewStack[int](1, 2, 3)
+	for item := range stack.Values() {
+		fmt.Println(item)
+	}
+	// Output:
+	// 3
+	// 2
+	// 1
+}
+
+func TestConcurrentStack_Close(t *testing.T) {
+	stack := NewConcurrentStack[int](1, 2)
+	stack.Close()
+	stack.Close()
+
+	if !stack.IsClosed() {
+		t.Errorf("IsClosed() = false, want true")
+	}
+	if err := stack.Push(3); err != ErrClosed {
+		t.Errorf("Push() error = %v, want %v", err, ErrClosed)
+	}
+
+	select {
+	case <-stack.Done():
+		t.Fatalf("Done() fired while items remain")
+	default:
+	}
+
+	for _, want := range []int{2, 1} {
+		if got, err := stack.Pop(); got != want || err != nil {
+			t.Errorf("Pop() = %v, %v, want %v, %v", got, err, want, nil)
+		}
+	}
+	if _, err := stack.Pop(); err != ErrClosed {
+		t.Errorf("Pop() error = %v, want %v", err, ErrClosed)
+	}
+
+	select {
+	case <-stack.Done():
+	default:
+		t.Errorf("Done() did not fire once the stack was closed and empty")
+	}
+}
+
+func TestConcurrentStack_DoneAfterConcurrentPops(t *testing.T) {
+	stack := NewConcurrentStack[int]()
+	for i := 0; i < 100; i++ {
+		_ = stack.Push(i)
+	}
+	stack.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if _, err := stack.Pop(); err != nil {
+					if err != ErrClosed {
+						t.Errorf("Pop() error = %v, want %v", err, ErrClosed)
+					}
+					return
+				}
+			}
+		}()
+	}
+
+	select {
+	case <-stack.Done():
+	case <-time.After(time.Second):
+		t.Errorf("Done() did not fire")
+	}
+	wg.Wait()
+}
+
+func BenchmarkConcurrentStack_Contention(b *testing.B) {
+	s := NewConcurrentStack[int]()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			_ = s.Push(i)
+			_, _ = s.Pop()
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package collections
+
+import (
+	"sync/atomic"
+)
+
+// minWorkStealingCapacity is the initial capacity of a work-stealing deque.
+const minWorkStealingCapacity = 32
+
+// workStealingBuffer is the circular array that backs a work-stealing deque.
+// Each slot holds a pointer to an item so that the owner and thieves can read
+// and write slots atomically.
+type workStealingBuffer[T any] struct {
+	slots []atomic.Pointer[T]
+	mask  int64
+}
+
+func newWorkStealingBuffer[T any](capacity int64) *workStealingBuffer[T] {
+	return &workStealingBuffer[T]{slots: make([]atomic.Pointer[T], capacity), mask: capacity - 1}
+}
+
+func (b *workStealingBuffer[T]) get(i int64) *T {
+	return b.slots[i&b.mask].Load()
+}
+
+func (b *workStealingBuffer[T]) put(i int64, item *T) {
+	b.slots[i&b.mask].Store(item)
+}
+
+// grow returns a buffer with twice the capacity that holds the items between
+// top and bottom.
+func (b *workStealingBuffer[T]) grow(bottom, top int64) *workStealingBuffer[T] {
+	grown := newWorkStealingBuffer[T](2 * int64(len(b.slots)))
+	for i := top; i < bottom; i++ {
+		grown.put(i, b.get(i))
+	}
+	return grown
+}
+
+// WorkStealingDeque implements the Chase-Lev work-stealing deque. A single
+// owner goroutine pushes and pops items at the bottom of the deque without
+// taking a lock, while any number of other goroutines can steal items from
+// the top. It is intended for fork/join task pools, where each worker owns a
+// deque and idle workers steal from the others.
+//
+// PushBottom and PopBottom must only be called by the owner. Steal, Size and
+// IsEmpty can be called from any goroutine.
+//
+// Every slot of the underlying array holds a pointer that is read and written
+// atomically, so PushBottom allocates a small box for each item. This keeps the
+// deque free of data races even when a thief reads a slot that the owner is
+// reusing.
+type WorkStealingDeque[T any] struct {
+	top    atomic.Int64
+	bottom atomic.Int64
+	buffer atomic.Pointer[workStealingBuffer[T]]
+}
+
+// NewWorkStealingDeque returns a new, empty work-stealing deque.
+func NewWorkStealingDeque[T any]() *WorkStealingDeque[T] {
+	d := &WorkStealingDeque[T]{}
+	d.buffer.Store(newWorkStealingBuffer[T](minWorkStealingCapacity))
+	return d
+}
+
+// PushBottom adds an item to the bottom of the deque. It must only be called by
+// the owner.
+func (d *WorkStealingDeque[T]) PushBottom(item T) {
+	b := d.bottom.Load()
+	t := d.top.Load()
+	buf := d.buffer.Load()
+	if b-t >= int64(len(buf.slots)) {
+		buf = buf.grow(b, t)
+		d.buffer.Store(buf)
+	}
+	buf.put(b, &item)
+	d.bottom.Store(b + 1)
+}
+
+// PopBottom removes and returns the item at the bottom of the deque, which is
+// the item most recently pushed. If the deque is empty, or a thief took the
+// last item first, ErrEmptyDeque is returned. It must only be called by the
+// owner.
+func (d *WorkStealingDeque[T]) PopBottom() (T, error) {
+	var zero T
+	b := d.bottom.Load() - 1
+	buf := d.buffer.Load()
+	d.bottom.Store(b)
+	t := d.top.Load()
+
+	if t > b {
+		d.bottom.Store(b + 1)
+		return zero, ErrEmptyDeque
+	}
+
+	item := buf.get(b)
+	if t == b {
+		// This is the last item, so race the thieves for it.
+		won := d.top.CompareAndSwap(t, t+1)
+		d.bottom.Store(b + 1)
+		if !won {
+			return zero, ErrEmptyDeque
+		}
+		buf.slots[b&buf.mask].CompareAndSwap(item, nil)
+		return *item, nil
+	}
+
+	buf.put(b, nil)
+	return *item, nil
+}
+
+// Steal removes and returns the item at the top of the deque, which is the
+// item least recently pushed. If the deque is empty, ErrEmptyDeque is
+// returned. If the steal loses a race with another thief or with the owner,
+// ErrStealAborted is returned and the steal can be retried. It can be called
+// from any goroutine.
+func (d *WorkStealingDeque[T]) Steal() (T, error) {
+	var zero T
+	t := d.top.Load()
+	b := d.bottom.Load()
+	if t >= b {
+		return zero, ErrEmptyDeque
+	}
+
+	buf := d.buffer.Load()
+	item := buf.get(t)
+	if !d.top.CompareAndSwap(t, t+1) {
+		return zero, ErrStealAborted
+	}
+
+	// Release the slot so that the item can be garbage collected, unless the
+	// owner has already reused it.
+	buf.slots[t&buf.mask].CompareAndSwap(item, nil)
+	return *item, nil
+}
+
+// Size returns the number of items in the deque. When other goroutines are
+// using the deque, the result is only an approximation.
+func (d *WorkStealingDeque[T]) Size() int {
+	n := d.bottom.Load() - d.top.Load()
+	if n < 0 {
+		return 0
+	}
+	return int(n)
+}
+
+// IsEmpty returns true if the deque is empty. When other goroutines are using
+// the deque, the result is only an approximation.
+func (d *WorkStealingDeque[T]) IsEmpty() bool {
+	return d.Size() == 0
+}
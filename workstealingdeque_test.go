@@ -0,0 +1,203 @@
+package collections
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWorkStealingDeque_Owner(t *testing.T) {
+	d := NewWorkStealingDeque[int]()
+	if _, err := d.PopBottom(); err != ErrEmptyDeque {
+		t.Errorf("PopBottom() error = %v, want %v", err, ErrEmptyDeque)
+	}
+
+	for i := 0; i < 3; i++ {
+		d.PushBottom(i)
+	}
+	if got := d.Size(); got != 3 {
+		t.Errorf("Size() = %v, want %v", got, 3)
+	}
+	for _, want := range []int{2, 1, 0} {
+		if got, err := d.PopBottom(); got != want || err != nil {
+			t.Errorf("PopBottom() = %v, %v, want %v, %v", got, err, want, nil)
+		}
+	}
+	if !d.IsEmpty() {
+		t.Errorf("IsEmpty() = false, want true")
+	}
+	if _, err := d.PopBottom(); err != ErrEmptyDeque {
+		t.Errorf("PopBottom() error = %v, want %v", err, ErrEmptyDeque)
+	}
+}
+
+func TestWorkStealingDeque_Steal(t *testing.T) {
+	d := NewWorkStealingDeque[int]()
+	if _, err := d.Steal(); err != ErrEmptyDeque {
+		t.Errorf("Steal() error = %v, want %v", err, ErrEmptyDeque)
+	}
+
+	for i := 0; i < 3; i++ {
+		d.PushBottom(i)
+	}
+	if got, err := d.Steal(); got != 0 || err != nil {
+		t.Errorf("Steal() = %v, %v, want %v, %v", got, err, 0, nil)
+	}
+	if got, err := d.PopBottom(); got != 2 || err != nil {
+		t.Errorf("PopBottom() = %v, %v, want %v, %v", got, err, 2, nil)
+	}
+	if got, err := d.Steal(); got != 1 || err != nil {
+		t.Errorf("Steal() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if _, err := d.Steal(); err != ErrEmptyDeque {
+		t.Errorf("Steal() error = %v, want %v", err, ErrEmptyDeque)
+	}
+}
+
+func TestWorkStealingDeque_Grow(t *testing.T) {
+	d := NewWorkStealingDeque[int]()
+	const n = 10 * minWorkStealingCapacity
+
+	// Steal a few items first so that top is not aligned with the buffer.
+	for i := 0; i < 5; i++ {
+		d.PushBottom(-1)
+		_, _ = d.Steal()
+	}
+	for i := 0; i < n; i++ {
+		d.PushBottom(i)
+	}
+	if got := len(d.buffer.Load().slots); got < n {
+		t.Errorf("capacity = %v, want at least %v", got, n)
+	}
+	for i := 0; i < n/2; i++ {
+		if got, err := d.Steal(); got != i || err != nil {
+			t.Fatalf("Steal() = %v, %v, want %v, %v", got, err, i, nil)
+		}
+	}
+	for i := n - 1; i >= n/2; i-- {
+		if got, err := d.PopBottom(); got != i || err != nil {
+			t.Fatalf("PopBottom() = %v, %v, want %v, %v", got, err, i, nil)
+		}
+	}
+}
+
+func TestWorkStealingDeque_Stress(t *testing.T) {
+	const items = 100000
+	const thieves = 4
+
+	d := NewWorkStealingDeque[int]()
+	seen := make([]atomic.Int32, items)
+	var consumed atomic.Int64
+	var aborted atomic.Int64
+	var stop atomic.Bool
+
+	take := func(item int) {
+		if seen[item].Add(1) != 1 {
+			t.Errorf("item %v taken more than once", item)
+		}
+		consumed.Add(1)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < thieves; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !stop.Load() {
+				item, err := d.Steal()
+				switch err {
+				case nil:
+					take(item)
+				case ErrStealAborted:
+					aborted.Add(1)
+				case ErrEmptyDeque:
+					runtime.Gosched()
+				default:
+					t.Errorf("Steal() error = %v", err)
+				}
+			}
+		}()
+	}
+
+	// The owner pushes items in bursts and pops some of them itself, so that
+	// it regularly races the thieves for the last item.
+	for i := 0; i < items; {
+		burst := i%7 + 1
+		for j := 0; j < burst && i < items; j++ {
+			d.PushBottom(i)
+			i++
+		}
+		for j := 0; j < burst/2; j++ {
+			if item, err := d.PopBottom(); err == nil {
+				take(item)
+			}
+		}
+	}
+	for {
+		item, err := d.PopBottom()
+		if err != nil {
+			break
+		}
+		take(item)
+	}
+	for consumed.Load() < items {
+		runtime.Gosched()
+	}
+	stop.Store(true)
+	wg.Wait()
+
+	for i := range seen {
+		if got := seen[i].Load(); got != 1 {
+			t.Fatalf("item %v taken %v times, want 1", i, got)
+		}
+	}
+	t.Logf("%d steals aborted", aborted.Load())
+}
+
+func BenchmarkWorkStealingDeque_Owner(b *testing.B) {
+	d := NewWorkStealingDeque[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.PushBottom(i)
+		_, _ = d.PopBottom()
+	}
+}
+
+func BenchmarkConcurrentStack_Owner(b *testing.B) {
+	s := NewConcurrentStack[int]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = s.Push(i)
+		_, _ = s.Pop()
+	}
+}
+
+func ExampleWorkStealingDeque() {
+	deque := NewWorkStealingDeque[string]()
+	deque.PushBottom("task 1")
+	deque.PushBottom("task 2")
+	deque.PushBottom("task 3")
+
+	// Another worker steals the oldest task
+	stolen := make(chan string)
+	go func() {
+		task, _ := deque.Steal()
+		stolen <- task
+	}()
+	fmt.Println("stolen:", <-stolen)
+
+	// The owner works on its newest tasks first
+	for {
+		task, err := deque.PopBottom()
+		if err != nil {
+			break
+		}
+		fmt.Println("owner:", task)
+	}
+	// Output:
+	// stolen: task 1
+	// owner: task 3
+	// owner: task 2
+}